@@ -1,5 +1,6 @@
 package tailwindmerge
 
+//#include <stdlib.h>
 //#include <zend.h>
 import "C"
 import "unsafe"
@@ -11,3 +12,53 @@ func zendStringToGoString(zendStr *C.zend_string) string {
 
 	return C.GoStringN((*C.char)(unsafe.Pointer(&zendStr.val)), C.int(zendStr.len))
 }
+
+func zendStringsToGoStrings(zendStrs **C.zend_string, count C.int) []string {
+	n := int(count)
+	if n == 0 {
+		return nil
+	}
+
+	values := make([]string, n)
+	cStrings := unsafe.Slice(zendStrs, n)
+	for i := 0; i < n; i++ {
+		values[i] = zendStringToGoString(cStrings[i])
+	}
+
+	return values
+}
+
+// goStringsToCArray copies values into a malloc'ed array of C strings. The
+// caller owns both the array and its elements.
+func goStringsToCArray(values []string, count *C.int) **C.char {
+	*count = C.int(len(values))
+	if len(values) == 0 {
+		return nil
+	}
+
+	array := (**C.char)(C.malloc(C.size_t(len(values)) * C.size_t(unsafe.Sizeof((*C.char)(nil)))))
+	cValues := unsafe.Slice(array, len(values))
+	for i, value := range values {
+		cValues[i] = C.CString(value)
+	}
+
+	return array
+}
+
+// cStringOrNil copies value into a malloc'ed C string, or returns nil for an
+// empty value.
+func cStringOrNil(value string) *C.char {
+	if value == "" {
+		return nil
+	}
+
+	return C.CString(value)
+}
+
+func cBool(value bool) C.int {
+	if value {
+		return 1
+	}
+
+	return 0
+}
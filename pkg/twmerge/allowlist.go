@@ -0,0 +1,126 @@
+package twmerge
+
+import (
+	"strings"
+	"sync"
+)
+
+// Allowlist restricts class lists from untrusted input, like classes typed
+// by CMS editors, to Tailwind classes and a few known classes of your own.
+type Allowlist struct {
+	// ClassGroups lists the class groups whose classes are kept, or nil to
+	// keep the classes of every class group.
+	ClassGroups []string
+	// Classes lists the non-Tailwind classes that are kept.
+	Classes []string
+	// Arbitrary keeps Tailwind classes with arbitrary values, properties or
+	// variables, like "bg-[url(x.png)]", "[position:fixed]" or
+	// "p-(--gap)", and arbitrary variants like "[&_*]:hidden". They can
+	// inject any CSS, so they are dropped unless set.
+	Arbitrary bool
+}
+
+// CreateSanitizer creates a function merging class lists from untrusted
+// input in allowlist mode, using config.Allowlist or, if it is nil, an
+// allowlist keeping the classes of every class group. Classes that are not
+// allowed, including Tailwind classes with unknown variants, are dropped and
+// returned in order of first appearance. The config is lazily initialized on
+// first call.
+func CreateSanitizer(getConfig func() *Config) func(classes ...string) (string, []string) {
+	var (
+		configUtils *ConfigUtils
+		once        sync.Once
+	)
+
+	return func(classes ...string) (string, []string) {
+		once.Do(func() {
+			config := getConfig()
+			if config.Allowlist == nil {
+				c := *config
+				c.Allowlist = &Allowlist{}
+				config = &c
+			}
+			configUtils = CreateConfigUtils(config)
+		})
+
+		classList := TwJoin(classes...)
+		return tailwindMerge(configUtils, classList), rejectedClasses(classList, configUtils)
+	}
+}
+
+func rejectedClasses(classList string, utils *ConfigUtils) []string {
+	var rejected []string
+	seen := make(map[string]struct{})
+
+	for _, className := range splitClassesRegex(utils.ExpandClassList(classList)) {
+		classUtils, _ := utils.utilsFor(className)
+		if classUtils.allowClass == nil {
+			continue
+		}
+
+		classGroupID := ""
+		if parsed := classUtils.ParseClassName(className); !parsed.IsExternal {
+			classGroupID, _ = classGroupIDOf(parsed, classUtils)
+		}
+		if classUtils.allowClass(className, classGroupID) {
+			continue
+		}
+		if _, ok := seen[className]; ok {
+			continue
+		}
+
+		seen[className] = struct{}{}
+		rejected = append(rejected, className)
+	}
+
+	return rejected
+}
+
+// createAllowClass creates the function reporting whether the allowlist of
+// config keeps a class of the given class group, "" for non-Tailwind
+// classes. Returns nil without an allowlist.
+func createAllowClass(config *Config, parseClassName func(string) ParsedClassName, isKnownVariant func(string) bool) func(className string, classGroupID string) bool {
+	allowlist := config.Allowlist
+	if allowlist == nil {
+		return nil
+	}
+
+	classes := make(map[string]bool, len(allowlist.Classes))
+	for _, className := range allowlist.Classes {
+		classes[className] = true
+	}
+
+	var classGroups map[string]bool
+	if allowlist.ClassGroups != nil {
+		classGroups = make(map[string]bool, len(allowlist.ClassGroups))
+		for _, classGroupID := range allowlist.ClassGroups {
+			classGroups[classGroupID] = true
+		}
+	}
+
+	return func(className string, classGroupID string) bool {
+		if classGroupID == "" {
+			return classes[className]
+		}
+		if classGroups != nil && !classGroups[classGroupID] {
+			return false
+		}
+		parsed := parseClassName(className)
+		if !allowlist.Arbitrary && isArbitrary(parsed.BaseClassName) {
+			return false
+		}
+		for _, modifier := range parsed.Modifiers {
+			if !allowlist.Arbitrary && isArbitrary(modifier) || !isKnownVariant(modifier) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// isArbitrary reports whether a base class or modifier has an arbitrary
+// part, like the "[url(x.png)]" of "bg-[url(x.png)]", the "(--gap)" of
+// "p-(--gap)" or the "[&_*]" arbitrary variant.
+func isArbitrary(part string) bool {
+	return strings.ContainsAny(part, "[(")
+}
@@ -0,0 +1,111 @@
+package twmerge
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCreateSanitizer(t *testing.T) {
+	tests := []struct {
+		name         string
+		allowlist    *Allowlist
+		classes      string
+		want         string
+		wantRejected []string
+	}{
+		{
+			name:         "default allowlist keeps every class group",
+			classes:      "p-2 hover:text-red-500 evil-class p-4",
+			want:         "hover:text-red-500 p-4",
+			wantRejected: []string{"evil-class"},
+		},
+		{
+			name:         "allowed non-Tailwind classes",
+			allowlist:    &Allowlist{Classes: []string{"prose", "lead"}},
+			classes:      "prose lead hacked p-2",
+			want:         "prose lead p-2",
+			wantRejected: []string{"hacked"},
+		},
+		{
+			name:         "subset of class groups",
+			allowlist:    &Allowlist{ClassGroups: []string{"text-color", "font-weight"}},
+			classes:      "text-red-500 font-bold fixed inset-0 z-50",
+			want:         "text-red-500 font-bold",
+			wantRejected: []string{"fixed", "inset-0", "z-50"},
+		},
+		{
+			name:         "unknown variants",
+			classes:      "hovr:p-2 hover:p-2 data-active:m-2",
+			want:         "hover:p-2 data-active:m-2",
+			wantRejected: []string{"hovr:p-2"},
+		},
+		{
+			name:         "arbitrary properties",
+			classes:      "[position:fixed] p-2",
+			want:         "p-2",
+			wantRejected: []string{"[position:fixed]"},
+		},
+		{
+			name:         "arbitrary values",
+			classes:      "bg-[url(https://evil.example/x.png)] p-(--gap) text-lg/[3] m-2",
+			want:         "m-2",
+			wantRejected: []string{"bg-[url(https://evil.example/x.png)]", "p-(--gap)", "text-lg/[3]"},
+		},
+		{
+			name:         "arbitrary variants",
+			classes:      "[&_*]:hidden data-[state=open]:block hover:p-2",
+			want:         "hover:p-2",
+			wantRejected: []string{"[&_*]:hidden", "data-[state=open]:block"},
+		},
+		{
+			name:      "arbitrary classes allowed explicitly",
+			allowlist: &Allowlist{Arbitrary: true},
+			classes:   "[position:fixed] bg-[url(https://evil.example/x.png)] [&_*]:hidden p-2",
+			want:      "[position:fixed] bg-[url(https://evil.example/x.png)] [&_*]:hidden p-2",
+		},
+		{
+			name:         "rejected classes are reported once",
+			classes:      "x p-2 x",
+			want:         "p-2",
+			wantRejected: []string{"x"},
+		},
+		{
+			name:    "nothing rejected",
+			classes: "p-2 p-4",
+			want:    "p-4",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sanitize := CreateSanitizer(func() *Config {
+				config := GetDefaultConfig()
+				config.Allowlist = tt.allowlist
+				return config
+			})
+			got, rejected := sanitize(tt.classes)
+			if got != tt.want {
+				t.Errorf("sanitize(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+			if !reflect.DeepEqual(rejected, tt.wantRejected) {
+				t.Errorf("sanitize(%q) rejected %q, want %q", tt.classes, rejected, tt.wantRejected)
+			}
+		})
+	}
+}
+
+func TestAllowlistWithPrefixes(t *testing.T) {
+	sanitize := CreateSanitizer(func() *Config {
+		config := GetDefaultConfig()
+		config.Prefixes = map[string]*Config{"tw": nil}
+		config.Allowlist = &Allowlist{Classes: []string{"card"}}
+		return config
+	})
+
+	got, rejected := sanitize("card tw:p-2 p-2 tw:evil")
+	if want := "card tw:p-2"; got != want {
+		t.Errorf("sanitize() = %q, want %q", got, want)
+	}
+	if want := []string{"p-2", "tw:evil"}; !reflect.DeepEqual(rejected, want) {
+		t.Errorf("sanitize() rejected %q, want %q", rejected, want)
+	}
+}
@@ -0,0 +1,225 @@
+package twmerge
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var customUtilityRegex = regexp.MustCompile(`@utility\s+(-?[A-Za-z_][\w-]*(?:-\*)?)\s*\{`)
+
+// responsiveBase is the key of the classes without a breakpoint in
+// Responsive.
+const responsiveBase = "base"
+
+// ApplyVariant adds variant, like "md" or "dark", in front of the modifiers
+// of every Tailwind class in classList and merges the result using the
+// default configuration. Important modifiers, arbitrary values and prefixes
+// are kept in place, and classes that already have the variant are left
+// alone. Classes of no known class group, like "card" or "js-toggle", only
+// get the variant if they are listed in Config.CustomUtilities.
+func ApplyVariant(classList string, variant string) string {
+	utils := getDefaultConfigUtils()
+	return tailwindMerge(utils, applyVariant(classList, variant, utils))
+}
+
+// StripVariant removes variant, or an alias of it, from the modifiers of
+// every Tailwind class in classList and merges the result using the default
+// configuration, so the classes apply without it.
+func StripVariant(classList string, variant string) string {
+	utils := getDefaultConfigUtils()
+	return tailwindMerge(utils, stripVariant(classList, variant, utils))
+}
+
+// SwapVariant replaces variant from, or an alias of it, with variant to in
+// the modifiers of every Tailwind class in classList, like turning "hover"
+// into "focus", and merges the result once using the default configuration.
+// Classes without from are left alone.
+func SwapVariant(classList string, from string, to string) string {
+	utils := getDefaultConfigUtils()
+	return tailwindMerge(utils, swapVariant(classList, from, to, utils))
+}
+
+// Responsive turns responsive props into one merged class list using the
+// default configuration. The classes under "base" apply without a variant,
+// and the classes under every other key get that key as a variant, like
+// "md" or "@lg". Breakpoints are applied from smallest to largest, so
+//
+//	Responsive(map[string]string{"base": "p-2", "md": "p-4"})
+//
+// returns "p-2 md:p-4".
+func Responsive(classes map[string]string) string {
+	utils := getDefaultConfigUtils()
+	return tailwindMerge(utils, responsive(classes, utils))
+}
+
+func applyVariant(classList string, variant string, utils *ConfigUtils) string {
+	return rewriteModifiers(classList, utils, func(classUtils *ConfigUtils, modifiers []string) []string {
+		canonical := classUtils.canonicalVariant(variant)
+		for _, modifier := range modifiers {
+			if classUtils.canonicalVariant(modifier) == canonical {
+				return modifiers
+			}
+		}
+		return append([]string{variant}, modifiers...)
+	})
+}
+
+func stripVariant(classList string, variant string, utils *ConfigUtils) string {
+	return rewriteModifiers(classList, utils, func(classUtils *ConfigUtils, modifiers []string) []string {
+		canonical := classUtils.canonicalVariant(variant)
+		kept := modifiers[:0:0]
+		for _, modifier := range modifiers {
+			if classUtils.canonicalVariant(modifier) != canonical {
+				kept = append(kept, modifier)
+			}
+		}
+		return kept
+	})
+}
+
+func swapVariant(classList string, from string, to string, utils *ConfigUtils) string {
+	return rewriteModifiers(classList, utils, func(classUtils *ConfigUtils, modifiers []string) []string {
+		canonicalFrom := classUtils.canonicalVariant(from)
+		canonicalTo := classUtils.canonicalVariant(to)
+		index := -1
+		for i, modifier := range modifiers {
+			switch classUtils.canonicalVariant(modifier) {
+			case canonicalFrom:
+				if index == -1 {
+					index = i
+				}
+			case canonicalTo:
+				// The class already has to, so from is just dropped.
+				index = len(modifiers)
+			}
+		}
+		if index == -1 {
+			return modifiers
+		}
+
+		swapped := modifiers[:0:0]
+		for i, modifier := range modifiers {
+			if i == index {
+				swapped = append(swapped, to)
+			} else if classUtils.canonicalVariant(modifier) != canonicalFrom {
+				swapped = append(swapped, modifier)
+			}
+		}
+		return swapped
+	})
+}
+
+func responsive(classes map[string]string, utils *ConfigUtils) string {
+	breakpoints := make(map[string]int, len(utils.config.Breakpoints))
+	for i, breakpoint := range utils.config.Breakpoints {
+		breakpoints[breakpoint] = i
+	}
+
+	variants := make([]string, 0, len(classes))
+	for variant := range classes {
+		if variant != responsiveBase && variant != "" {
+			variants = append(variants, variant)
+		}
+	}
+
+	// Breakpoints come first, from smallest to largest, then other
+	// variants alphabetically.
+	sort.Slice(variants, func(i, j int) bool {
+		a, aIsBreakpoint := breakpoints[variants[i]]
+		b, bIsBreakpoint := breakpoints[variants[j]]
+		if aIsBreakpoint != bIsBreakpoint {
+			return aIsBreakpoint
+		}
+		if aIsBreakpoint {
+			return a < b
+		}
+		return variants[i] < variants[j]
+	})
+
+	classLists := []string{classes[""], classes[responsiveBase]}
+	for _, variant := range variants {
+		classLists = append(classLists, applyVariant(classes[variant], variant, utils))
+	}
+
+	return TwJoin(classLists...)
+}
+
+// rewriteModifiers replaces the modifiers of the Tailwind classes in
+// classList with the result of rewrite. Other classes are left alone: those
+// without the prefix of the config, malformed ones and those of no class
+// group that are not custom utilities.
+func rewriteModifiers(classList string, utils *ConfigUtils, rewrite func(classUtils *ConfigUtils, modifiers []string) []string) string {
+	classNames := splitClassesRegex(utils.ExpandClassList(classList))
+	for i, className := range classNames {
+		classUtils, _ := utils.utilsFor(className)
+		parsed := classUtils.ParseClassName(className)
+		// Malformed classes, like the halves of a variant group when
+		// variant groups are disabled, are not classes to add a variant to.
+		if parsed.IsExternal || classUtils.validateClassName(className) != nil {
+			continue
+		}
+		if classGroupID, _ := classGroupIDOf(parsed, classUtils); classGroupID == "" && !classUtils.isCustomUtility(parsed.BaseClassName) {
+			continue
+		}
+
+		modifiers := append([]string(nil), parsed.Modifiers...)
+		classNames[i] = classUtils.withModifiers(className, rewrite(classUtils, modifiers))
+	}
+	return strings.Join(classNames, " ")
+}
+
+// isCustomUtility reports whether baseClassName is one of the utilities of
+// Config.CustomUtilities.
+func (u *ConfigUtils) isCustomUtility(baseClassName string) bool {
+	baseClassName = strings.TrimPrefix(baseClassName, "-")
+	for _, utility := range u.config.CustomUtilities {
+		if root, ok := strings.CutSuffix(utility, "*"); ok {
+			if strings.HasPrefix(baseClassName, root) && len(baseClassName) > len(root) {
+				return true
+			}
+		} else if baseClassName == utility {
+			return true
+		}
+	}
+	return false
+}
+
+// CustomUtilitiesFromCSS extracts the names of the utilities defined with
+// @utility in CSS, for use as Config.CustomUtilities, like "card" and
+// "tab-*" in
+//
+//	@utility card { border-radius: 0.5rem; }
+//	@utility tab-* { tab-size: --value(integer); }
+func CustomUtilitiesFromCSS(css string) []string {
+	var utilities []string
+	for _, match := range customUtilityRegex.FindAllStringSubmatch(cssCommentRegex.ReplaceAllString(css, ""), -1) {
+		utilities = append(utilities, match[1])
+	}
+	return utilities
+}
+
+// withModifiers returns className with its modifiers replaced by modifiers,
+// keeping its prefix and its base class with the important modifier.
+func (u *ConfigUtils) withModifiers(className string, modifiers []string) string {
+	separator := separatorOf(u.config)
+
+	var prefix string
+	if u.config.Prefix != "" && !u.config.LegacyPrefix {
+		prefix = u.config.Prefix + separator
+	}
+
+	base := className[baseClassStart(className, separator):]
+	if len(modifiers) == 0 {
+		return prefix + base
+	}
+	return prefix + strings.Join(modifiers, separator) + separator + base
+}
+
+// canonicalVariant resolves variant aliases, like min-md for md.
+func (u *ConfigUtils) canonicalVariant(variant string) string {
+	if canonical, ok := u.config.VariantAliases[variant]; ok {
+		return canonical
+	}
+	return variant
+}
@@ -0,0 +1,170 @@
+package twmerge
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestApplyVariant(t *testing.T) {
+	tests := []struct {
+		classes string
+		variant string
+		want    string
+	}{
+		{"p-2 text-lg", "md", "md:p-2 md:text-lg"},
+		{"hover:bg-red-500", "dark", "dark:hover:bg-red-500"},
+		{"!p-2 m-4!", "md", "md:!p-2 md:m-4!"},
+		{"bg-[url(a:b.png)] w-[calc(100%-1rem)]", "lg", "lg:bg-[url(a:b.png)] lg:w-[calc(100%-1rem)]"},
+		{"-mt-2 [mask-type:luminance]", "sm", "sm:-mt-2 sm:[mask-type:luminance]"},
+		{"card p-2", "md", "card md:p-2"},
+		{"card js-toggle p-2", "md", "card js-toggle md:p-2"},
+		{"md:p-2 p-4", "md", "md:p-4"},
+		{"min-md:p-2", "md", "min-md:p-2"},
+		{"hover:(p-2 m-2)", "md", "hover:(p-2 m-2)"},
+		{"", "md", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.variant+" "+tt.classes, func(t *testing.T) {
+			if got := ApplyVariant(tt.classes, tt.variant); got != tt.want {
+				t.Errorf("ApplyVariant(%q, %q) = %q, want %q", tt.classes, tt.variant, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStripVariant(t *testing.T) {
+	tests := []struct {
+		classes string
+		variant string
+		want    string
+	}{
+		{"dark:bg-black dark:text-white p-2", "dark", "bg-black text-white p-2"},
+		{"dark:hover:p-2 hover:dark:m-2", "dark", "hover:p-2 hover:m-2"},
+		{"p-2 dark:p-4", "dark", "p-4"},
+		{"dark:!p-2 dark:m-2!", "dark", "!p-2 m-2!"},
+		{"min-md:p-2 lg:p-4", "md", "p-2 lg:p-4"},
+		{"dark:card", "dark", "dark:card"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.variant+" "+tt.classes, func(t *testing.T) {
+			if got := StripVariant(tt.classes, tt.variant); got != tt.want {
+				t.Errorf("StripVariant(%q, %q) = %q, want %q", tt.classes, tt.variant, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyVariant_CustomUtilities(t *testing.T) {
+	config := GetDefaultConfig()
+	config.CustomUtilities = []string{"card", "tab-*"}
+	utils := CreateConfigUtils(config)
+
+	tests := []struct {
+		classes string
+		want    string
+	}{
+		{"card js-toggle p-2", "md:card js-toggle md:p-2"},
+		{"tab-4 hover:tab-8! tab-", "md:tab-4 md:hover:tab-8! tab-"},
+		{"card-lg", "card-lg"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.classes, func(t *testing.T) {
+			if got := applyVariant(tt.classes, "md", utils); got != tt.want {
+				t.Errorf("applyVariant(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+
+	if got, want := stripVariant("md:card md:js-toggle", "md", utils), "card md:js-toggle"; got != want {
+		t.Errorf("stripVariant() = %q, want %q", got, want)
+	}
+}
+
+func TestCustomUtilitiesFromCSS(t *testing.T) {
+	css := `
+@utility card { border-radius: 0.5rem; }
+/* @utility commented { display: none; } */
+@utility tab-* {
+  tab-size: --value(integer);
+}
+.btn { @apply px-4; }
+`
+	want := []string{"card", "tab-*"}
+	if got := CustomUtilitiesFromCSS(css); !reflect.DeepEqual(got, want) {
+		t.Errorf("CustomUtilitiesFromCSS() = %q, want %q", got, want)
+	}
+}
+
+func TestSwapVariant(t *testing.T) {
+	tests := []struct {
+		classes string
+		from    string
+		to      string
+		want    string
+	}{
+		{"hover:bg-red-500 hover:text-white p-2", "hover", "focus", "focus:bg-red-500 focus:text-white p-2"},
+		{"md:hover:p-2 hover:dark:m-2!", "hover", "focus", "md:focus:p-2 focus:dark:m-2!"},
+		{"p-2 md:p-4", "md", "lg", "p-2 lg:p-4"},
+		{"min-md:p-2", "md", "lg", "lg:p-2"},
+		{"md:lg:p-2", "md", "lg", "lg:p-2"},
+		{"hover:card hover:js-toggle", "hover", "focus", "hover:card hover:js-toggle"},
+		// Unlike StripVariant followed by ApplyVariant, nothing is merged
+		// while hover:p-2 has no variant, so p-4 does not override it.
+		{"hover:p-2 p-4", "hover", "focus", "focus:p-2 p-4"},
+		{"lg:p-4 md:p-2", "md", "lg", "lg:p-2"},
+		{"", "md", "lg", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.from+" "+tt.to+" "+tt.classes, func(t *testing.T) {
+			if got := SwapVariant(tt.classes, tt.from, tt.to); got != tt.want {
+				t.Errorf("SwapVariant(%q, %q, %q) = %q, want %q", tt.classes, tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResponsive(t *testing.T) {
+	tests := []struct {
+		name    string
+		classes map[string]string
+		want    string
+	}{
+		{"base and breakpoint", map[string]string{"base": "p-2", "md": "p-4"}, "p-2 md:p-4"},
+		{"breakpoints in order", map[string]string{"xl": "p-8", "sm": "p-4 text-lg", "base": "p-2"}, "p-2 sm:p-4 sm:text-lg xl:p-8"},
+		{"other variants after breakpoints", map[string]string{"@md": "p-6", "md": "p-4", "dark": "p-1"}, "md:p-4 @md:p-6 dark:p-1"},
+		{"important and arbitrary values", map[string]string{"base": "!p-2", "lg": "w-[calc(100%-1rem)]!"}, "!p-2 lg:w-[calc(100%-1rem)]!"},
+		{"merged per breakpoint", map[string]string{"md": "p-2 p-4", "base": "p-1 p-3"}, "p-3 md:p-4"},
+		{"own classes", map[string]string{"md": "p-4 card-lg"}, "md:p-4 card-lg"},
+		{"empty", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Responsive(tt.classes); got != tt.want {
+				t.Errorf("Responsive(%v) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyVariantWithPrefix(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  func(*Config)
+		classes string
+		want    string
+	}{
+		{"variant prefix", func(c *Config) { c.Prefix = "tw" }, "tw:p-2 tw:hover:m-2 p-4", "tw:md:p-2 tw:md:hover:m-2 p-4"},
+		{"glued prefix", func(c *Config) { c.Prefix = "tw-"; c.LegacyPrefix = true }, "tw-p-2 hover:-tw-m-2 card", "md:tw-p-2 md:hover:-tw-m-2 card"},
+		{"separator", func(c *Config) { c.Separator = "_" }, "hover_p-2", "md_hover_p-2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := GetDefaultConfig()
+			tt.config(config)
+			utils := CreateConfigUtils(config)
+			if got := applyVariant(tt.classes, "md", utils); got != tt.want {
+				t.Errorf("applyVariant(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
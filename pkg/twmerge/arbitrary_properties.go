@@ -0,0 +1,127 @@
+package twmerge
+
+import "sort"
+
+// withArbitraryPropertyConflicts returns conflictingClassGroups extended so
+// arbitrary properties take part in conflict resolution:
+//
+//   - An arbitrary property overrides the class groups mapped to it and to
+//     its longhands, as well as arbitrary properties for those longhands, so
+//     "[padding:1rem]" overrides "px-2" and "[padding-left:2px]".
+//   - A class group overrides arbitrary properties for the CSS properties it
+//     and the groups it conflicts with set, plus their longhands, so "p-4"
+//     overrides "[padding:1rem]" and "[padding-left:2px]".
+//
+// The given maps are not modified.
+func withArbitraryPropertyConflicts(conflictingClassGroups, arbitraryPropertyClassGroups, cssPropertyLonghands map[string][]string) map[string][]string {
+	if len(arbitraryPropertyClassGroups) == 0 && len(cssPropertyLonghands) == 0 {
+		return conflictingClassGroups
+	}
+
+	conflicts := make(map[string][]string, len(conflictingClassGroups))
+	for classGroupID, groups := range conflictingClassGroups {
+		conflicts[classGroupID] = groups
+	}
+
+	longhands := make(map[string][]string)
+	longhandsOf := func(property string) []string {
+		if result, ok := longhands[property]; ok {
+			return result
+		}
+		result := collectLonghands(property, cssPropertyLonghands)
+		longhands[property] = result
+		return result
+	}
+
+	properties := make(map[string]struct{})
+	propertiesOfGroup := make(map[string][]string)
+	for property, groups := range arbitraryPropertyClassGroups {
+		properties[property] = struct{}{}
+		for _, classGroupID := range groups {
+			propertiesOfGroup[classGroupID] = append(propertiesOfGroup[classGroupID], property)
+		}
+	}
+	for property := range cssPropertyLonghands {
+		properties[property] = struct{}{}
+	}
+
+	for property := range properties {
+		var extra []string
+		for _, longhand := range longhandsOf(property) {
+			extra = append(extra, arbitraryPropertyPrefix+longhand)
+		}
+		for _, p := range append([]string{property}, longhandsOf(property)...) {
+			extra = append(extra, arbitraryPropertyClassGroups[p]...)
+		}
+
+		classGroupID := arbitraryPropertyPrefix + property
+		conflicts[classGroupID] = appendUnique(conflictingClassGroups[classGroupID], extra)
+	}
+
+	for classGroupID := range propertiesOfGroup {
+		var extra []string
+		for _, group := range append([]string{classGroupID}, conflictingClassGroups[classGroupID]...) {
+			for _, property := range propertiesOfGroup[group] {
+				extra = append(extra, arbitraryPropertyPrefix+property)
+				for _, longhand := range longhandsOf(property) {
+					extra = append(extra, arbitraryPropertyPrefix+longhand)
+				}
+			}
+		}
+
+		conflicts[classGroupID] = appendUnique(conflictingClassGroups[classGroupID], extra)
+	}
+
+	return conflicts
+}
+
+// collectLonghands returns all properties set by a shorthand CSS property,
+// following nested shorthands such as padding → padding-inline →
+// padding-inline-start. The result is sorted and excludes property itself.
+func collectLonghands(property string, cssPropertyLonghands map[string][]string) []string {
+	seen := map[string]struct{}{property: {}}
+	var result []string
+
+	pending := append([]string(nil), cssPropertyLonghands[property]...)
+	for len(pending) > 0 {
+		longhand := pending[0]
+		pending = pending[1:]
+
+		if _, ok := seen[longhand]; ok {
+			continue
+		}
+		seen[longhand] = struct{}{}
+		result = append(result, longhand)
+		pending = append(pending, cssPropertyLonghands[longhand]...)
+	}
+
+	sort.Strings(result)
+	return result
+}
+
+// appendUnique returns base followed by the sorted values of extra that are
+// not already present. base is never modified.
+func appendUnique(base []string, extra []string) []string {
+	seen := make(map[string]struct{}, len(base)+len(extra))
+	for _, value := range base {
+		seen[value] = struct{}{}
+	}
+
+	var added []string
+	for _, value := range extra {
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		added = append(added, value)
+	}
+
+	if len(added) == 0 {
+		return base
+	}
+
+	sort.Strings(added)
+	result := make([]string, 0, len(base)+len(added))
+	result = append(result, base...)
+	return append(result, added...)
+}
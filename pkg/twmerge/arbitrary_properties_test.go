@@ -110,3 +110,79 @@ func TestArbitraryPropertyImportantModifier(t *testing.T) {
 		})
 	}
 }
+
+func TestArbitraryPropertyCrossConflicts(t *testing.T) {
+	tests := []struct {
+		name    string
+		classes string
+		want    string
+	}{
+		{
+			name:    "arbitrary shorthand overrides utility",
+			classes: "p-4 [padding:1rem]",
+			want:    "[padding:1rem]",
+		},
+		{
+			name:    "arbitrary shorthand overrides longhand utilities",
+			classes: "px-2 pt-3 [padding:1rem]",
+			want:    "[padding:1rem]",
+		},
+		{
+			name:    "longhand utility after arbitrary shorthand keeps both",
+			classes: "[padding:1rem] pl-2",
+			want:    "[padding:1rem] pl-2",
+		},
+		{
+			name:    "utility overrides arbitrary shorthand",
+			classes: "[padding:1rem] p-4",
+			want:    "p-4",
+		},
+		{
+			name:    "shorthand utility overrides arbitrary longhand",
+			classes: "[padding-left:2px] p-4",
+			want:    "p-4",
+		},
+		{
+			name:    "arbitrary shorthand overrides arbitrary longhand",
+			classes: "[padding-left:2px] [padding:1rem]",
+			want:    "[padding:1rem]",
+		},
+		{
+			name:    "arbitrary longhand overrides matching utility",
+			classes: "pl-4 [padding-left:2px]",
+			want:    "[padding-left:2px]",
+		},
+		{
+			name:    "unrelated properties do not conflict",
+			classes: "pl-4 [padding-right:2px]",
+			want:    "pl-4 [padding-right:2px]",
+		},
+		{
+			name:    "utility overrides arbitrary properties it sets implicitly",
+			classes: "[line-height:2] text-lg",
+			want:    "text-lg",
+		},
+		{
+			name:    "arbitrary property does not override implicit conflicts",
+			classes: "leading-6 [font-size:2rem]",
+			want:    "leading-6 [font-size:2rem]",
+		},
+		{
+			name:    "modifiers are respected",
+			classes: "hover:p-4 [padding:1rem] hover:[padding:2rem]",
+			want:    "[padding:1rem] hover:[padding:2rem]",
+		},
+		{
+			name:    "color property maps to text color",
+			classes: "text-red-500 [color:blue]",
+			want:    "[color:blue]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TwMerge(tt.classes); got != tt.want {
+				t.Errorf("TwMerge(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
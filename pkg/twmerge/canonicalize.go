@@ -0,0 +1,149 @@
+package twmerge
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// rootFontSizePx is the root font size used to convert px to rem.
+const rootFontSizePx = 16
+
+var arbitraryLengthRegex = regexp.MustCompile(`^\[(?:length:)?(-?\d*\.?\d+)(rem|px)?\]$`)
+
+// createCanonicalizeArbitraryValues creates the pre-pass rewriting arbitrary
+// values of spacing utilities to their exact theme equivalent, such as
+// "p-[1rem]" to "p-4" and "mt-[-4px]" to "-mt-1". Values without an exact
+// equivalent in Theme["spacing"] are left alone, as are all values if
+// config.SpacingUnit is not set.
+func createCanonicalizeArbitraryValues(config *Config, parseClassName func(string) ParsedClassName, getClassGroupID func(string) string) func(string) string {
+	spacingUnit := config.SpacingUnit
+	if spacingUnit <= 0 {
+		return func(classList string) string { return classList }
+	}
+
+	spacingGroups := make(map[string]bool)
+	for classGroupID, definitions := range config.ClassGroups {
+		if classGroupHasTheme(definitions, "spacing") {
+			spacingGroups[classGroupID] = true
+		}
+	}
+	spacingTheme := config.Theme["spacing"]
+
+	canonicalize := func(className string) string {
+		parsed := parseClassName(className)
+		if parsed.IsExternal || parsed.MaybePostfixModifierPosition != -1 {
+			return className
+		}
+
+		base := parsed.BaseClassName
+		valueStart := strings.LastIndex(base, classPartSeparator+"[")
+		if valueStart <= 0 {
+			return className
+		}
+
+		classGroupID := getClassGroupID(base)
+		if !spacingGroups[classGroupID] {
+			return className
+		}
+
+		step, ok := spacingStep(base[valueStart+1:], spacingUnit)
+		if !ok || !matchesTheme(formatSpacingStep(math.Abs(step)), spacingTheme) {
+			return className
+		}
+
+		utility := base[:valueStart]
+		if step < 0 {
+			if strings.HasPrefix(utility, classPartSeparator) {
+				return className
+			}
+			utility = classPartSeparator + utility
+		}
+		candidate := utility + classPartSeparator + formatSpacingStep(math.Abs(step))
+		if getClassGroupID(candidate) != classGroupID {
+			return className
+		}
+
+		i := strings.LastIndex(className, base)
+		if config.LegacyPrefix && step < 0 && strings.HasSuffix(className[:i], config.Prefix) {
+			// The minus sign goes in front of a glued prefix: "-tw-mt-1".
+			i -= len(config.Prefix)
+			candidate = classPartSeparator + config.Prefix + candidate[1:]
+			base = config.Prefix + base
+		}
+		return className[:i] + candidate + className[i+len(base):]
+	}
+
+	return func(classList string) string {
+		classNames := splitClassesRegex(classList)
+		for i, className := range classNames {
+			classNames[i] = canonicalize(className)
+		}
+		return strings.Join(classNames, " ")
+	}
+}
+
+// spacingStep converts an arbitrary length like "[1rem]" or "[-4px]" into a
+// number of spacing steps of unit rem. Only exact multiples of a quarter step
+// are valid.
+func spacingStep(value string, unit float64) (float64, bool) {
+	match := arbitraryLengthRegex.FindStringSubmatch(value)
+	if match == nil {
+		return 0, false
+	}
+
+	length, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0, false
+	}
+
+	switch match[2] {
+	case "rem":
+		length /= unit
+	case "px":
+		if math.Abs(length) == 1 {
+			// 1px maps to the "px" spacing value rather than a step.
+			return math.Copysign(math.Inf(1), length), true
+		}
+		length /= unit * rootFontSizePx
+	default:
+		if length != 0 {
+			return 0, false
+		}
+	}
+
+	if math.Mod(length*4, 1) != 0 {
+		return 0, false
+	}
+	return length, true
+}
+
+func formatSpacingStep(step float64) string {
+	if math.IsInf(step, 0) {
+		return "px"
+	}
+	return strconv.FormatFloat(step, 'f', -1, 64)
+}
+
+// matchesTheme reports whether value is accepted by the theme definitions,
+// either as a literal or through a validator.
+func matchesTheme(value string, definitions []ClassDefinition) bool {
+	for _, def := range definitions {
+		switch v := def.(type) {
+		case string:
+			if v == value {
+				return true
+			}
+		case ClassValidator:
+			if v(value) {
+				return true
+			}
+		case func(string) bool:
+			if v(value) {
+				return true
+			}
+		}
+	}
+	return false
+}
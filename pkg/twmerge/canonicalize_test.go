@@ -0,0 +1,142 @@
+package twmerge
+
+import "testing"
+
+func TestCanonicalizeArbitraryValues(t *testing.T) {
+	merge := CreateTailwindMerge(func() *Config {
+		config := GetDefaultConfig()
+		config.CanonicalizeArbitraryValues = true
+		return config
+	})
+
+	tests := []struct {
+		name    string
+		classes string
+		want    string
+	}{
+		{
+			name:    "rem value",
+			classes: "p-[1rem]",
+			want:    "p-4",
+		},
+		{
+			name:    "px value",
+			classes: "mt-[4px]",
+			want:    "mt-1",
+		},
+		{
+			name:    "fractional step",
+			classes: "gap-[0.375rem] w-[10px]",
+			want:    "gap-1.5 w-2.5",
+		},
+		{
+			name:    "one pixel",
+			classes: "h-[1px]",
+			want:    "h-px",
+		},
+		{
+			name:    "zero",
+			classes: "m-[0] p-[0px]",
+			want:    "m-0 p-0",
+		},
+		{
+			name:    "labeled value",
+			classes: "px-[length:2rem]",
+			want:    "px-8",
+		},
+		{
+			name:    "negative value",
+			classes: "mt-[-4px] -ml-[1px] inset-x-[-0.5rem]",
+			want:    "-mt-1 -ml-px -inset-x-2",
+		},
+		{
+			name:    "double negative is left alone",
+			classes: "-mt-[-4px]",
+			want:    "-mt-[-4px]",
+		},
+		{
+			name:    "modifiers and important",
+			classes: "md:hover:p-[1rem]! !m-[8px]",
+			want:    "md:hover:p-4! !m-2",
+		},
+		{
+			name:    "dedupes with theme value",
+			classes: "p-4 p-[1rem]",
+			want:    "p-4",
+		},
+		{
+			name:    "no exact step",
+			classes: "p-[0.3rem] m-[2.5px]",
+			want:    "p-[0.3rem] m-[2.5px]",
+		},
+		{
+			name:    "other units",
+			classes: "p-[1em] w-[50%] h-[calc(1rem+2px)]",
+			want:    "p-[1em] w-[50%] h-[calc(1rem+2px)]",
+		},
+		{
+			name:    "non-spacing group",
+			classes: "text-[16px] rounded-[4px] border-[2px]",
+			want:    "text-[16px] rounded-[4px] border-[2px]",
+		},
+		{
+			name:    "arbitrary variables",
+			classes: "p-[var(--gap)] p-(--gap)",
+			want:    "p-(--gap)",
+		},
+		{
+			name:    "postfix modifier",
+			classes: "text-lg/[16px]",
+			want:    "text-lg/[16px]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := merge(tt.classes); got != tt.want {
+				t.Errorf("merge(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanonicalizeArbitraryValues_DisabledByDefault(t *testing.T) {
+	if got, want := TwMerge("p-[1rem]"), "p-[1rem]"; got != want {
+		t.Errorf("TwMerge() = %q, want %q", got, want)
+	}
+}
+
+func TestCanonicalizeArbitraryValues_SpacingUnit(t *testing.T) {
+	tests := []struct {
+		name        string
+		spacingUnit float64
+		classes     string
+		want        string
+	}{
+		{
+			name:        "custom unit",
+			spacingUnit: 0.5,
+			classes:     "p-[1rem] mt-[4px]",
+			want:        "p-2 mt-0.5",
+		},
+		{
+			name:        "unknown unit",
+			spacingUnit: 0,
+			classes:     "p-[1rem] mt-[4px]",
+			want:        "p-[1rem] mt-[4px]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merge := CreateTailwindMerge(func() *Config {
+				config := GetDefaultConfig()
+				config.CanonicalizeArbitraryValues = true
+				config.SpacingUnit = tt.spacingUnit
+				return config
+			})
+			if got := merge(tt.classes); got != tt.want {
+				t.Errorf("merge(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package twmerge
+
+// deriveConflictingClassGroups returns the conflicting class groups of the
+// config, with conflicts computed from ClassGroupProperties for every group
+// that has no hand-written entry. Group A conflicts with group B when all
+// properties B sets, including longhands, are also set by A.
+func deriveConflictingClassGroups(config *Config) map[string][]string {
+	if len(config.ClassGroupProperties) == 0 {
+		return config.ConflictingClassGroups
+	}
+
+	coveredProperties := make(map[string]map[string]struct{}, len(config.ClassGroupProperties))
+	for classGroupID, properties := range config.ClassGroupProperties {
+		covered := make(map[string]struct{})
+		for _, property := range properties {
+			covered[property] = struct{}{}
+			for _, longhand := range collectLonghands(property, config.CSSPropertyLonghands) {
+				covered[longhand] = struct{}{}
+			}
+		}
+		coveredProperties[classGroupID] = covered
+	}
+
+	conflicts := make(map[string][]string, len(config.ConflictingClassGroups)+len(coveredProperties))
+	for classGroupID, groups := range config.ConflictingClassGroups {
+		conflicts[classGroupID] = groups
+	}
+
+	for classGroupID, covered := range coveredProperties {
+		if _, ok := config.ConflictingClassGroups[classGroupID]; ok {
+			continue
+		}
+
+		var derived []string
+		for otherID, otherCovered := range coveredProperties {
+			if otherID == classGroupID || len(otherCovered) == 0 {
+				continue
+			}
+			if coversAll(covered, otherCovered) {
+				derived = append(derived, otherID)
+			}
+		}
+
+		if len(derived) > 0 {
+			conflicts[classGroupID] = appendUnique(nil, derived)
+		}
+	}
+
+	return conflicts
+}
+
+// deriveArbitraryPropertyClassGroups returns the arbitrary property mapping
+// of the config, extended with every class group that sets exactly one CSS
+// property not already mapped explicitly.
+func deriveArbitraryPropertyClassGroups(config *Config) map[string][]string {
+	if len(config.ClassGroupProperties) == 0 {
+		return config.ArbitraryPropertyClassGroups
+	}
+
+	derived := make(map[string][]string)
+	for classGroupID, properties := range config.ClassGroupProperties {
+		if len(properties) != 1 {
+			continue
+		}
+		if _, ok := config.ArbitraryPropertyClassGroups[properties[0]]; ok {
+			continue
+		}
+		derived[properties[0]] = append(derived[properties[0]], classGroupID)
+	}
+
+	if len(derived) == 0 {
+		return config.ArbitraryPropertyClassGroups
+	}
+
+	result := make(map[string][]string, len(config.ArbitraryPropertyClassGroups)+len(derived))
+	for property, groups := range config.ArbitraryPropertyClassGroups {
+		result[property] = groups
+	}
+	for property, groups := range derived {
+		result[property] = appendUnique(nil, groups)
+	}
+
+	return result
+}
+
+func coversAll(set, subset map[string]struct{}) bool {
+	for property := range subset {
+		if _, ok := set[property]; !ok {
+			return false
+		}
+	}
+	return true
+}
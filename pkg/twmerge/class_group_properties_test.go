@@ -0,0 +1,104 @@
+package twmerge
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func newPropertiesTestConfig() *Config {
+	spacing := []ClassDefinition{IsNumber, IsArbitraryValue}
+
+	return &Config{
+		ClassGroups: map[string][]ClassDefinition{
+			"pad":       {m("pad", spacing...)},
+			"pad-x":     {m("pad-x", spacing...)},
+			"pad-l":     {m("pad-l", spacing...)},
+			"pad-r":     {m("pad-r", spacing...)},
+			"pad-t":     {m("pad-t", spacing...)},
+			"frame":     {m("frame", spacing...)},
+			"frame-w":   {m("frame-w", spacing...)},
+			"text-fill": {m("text-fill", IsAny)},
+		},
+		ClassGroupProperties: map[string][]string{
+			"pad":       {"padding"},
+			"pad-x":     {"padding-left", "padding-right"},
+			"pad-l":     {"padding-left"},
+			"pad-r":     {"padding-right"},
+			"pad-t":     {"padding-top"},
+			"frame":     {"width", "height"},
+			"frame-w":   {"width"},
+			"text-fill": {"color"},
+		},
+		ConflictingClassGroups: map[string][]string{
+			"frame": {},
+		},
+		CSSPropertyLonghands: map[string][]string{
+			"padding": {"padding-top", "padding-right", "padding-bottom", "padding-left"},
+		},
+	}
+}
+
+func TestClassGroupProperties_DerivedConflicts(t *testing.T) {
+	merge := CreateTailwindMerge(newPropertiesTestConfig)
+
+	tests := []struct {
+		name    string
+		classes string
+		want    string
+	}{
+		{
+			name:    "shorthand overrides longhands",
+			classes: "pad-x-2 pad-t-1 pad-4",
+			want:    "pad-4",
+		},
+		{
+			name:    "partial shorthand overrides its longhands",
+			classes: "pad-l-2 pad-r-1 pad-x-4",
+			want:    "pad-x-4",
+		},
+		{
+			name:    "partial shorthand keeps unrelated longhands",
+			classes: "pad-t-2 pad-x-4",
+			want:    "pad-t-2 pad-x-4",
+		},
+		{
+			name:    "longhand does not override shorthand",
+			classes: "pad-4 pad-l-2",
+			want:    "pad-4 pad-l-2",
+		},
+		{
+			name:    "hand-written conflicts take precedence",
+			classes: "frame-w-2 frame-4",
+			want:    "frame-w-2 frame-4",
+		},
+		{
+			name:    "single property groups handle arbitrary properties",
+			classes: "text-fill-red [color:blue] pad-l-2 [padding:1rem]",
+			want:    "[color:blue] [padding:1rem]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := merge(tt.classes); got != tt.want {
+				t.Errorf("merge(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassGroupProperties_DerivedConflictMap(t *testing.T) {
+	derived := deriveConflictingClassGroups(newPropertiesTestConfig())
+	for _, groups := range derived {
+		sort.Strings(groups)
+	}
+
+	want := map[string][]string{
+		"pad":   {"pad-l", "pad-r", "pad-t", "pad-x"},
+		"pad-x": {"pad-l", "pad-r"},
+		"frame": {},
+	}
+	if !reflect.DeepEqual(derived, want) {
+		t.Errorf("deriveConflictingClassGroups() = %v, want %v", derived, want)
+	}
+}
@@ -38,9 +38,15 @@ type ClassGroupUtils struct {
 
 // CreateClassGroupUtils builds the trie and conflict lookups from config.
 func CreateClassGroupUtils(config *Config) *ClassGroupUtils {
+	conflictingClassGroups := withArbitraryPropertyConflicts(
+		deriveConflictingClassGroups(config),
+		deriveArbitraryPropertyClassGroups(config),
+		config.CSSPropertyLonghands,
+	)
+
 	return &ClassGroupUtils{
 		classMap:                       CreateClassMap(config),
-		conflictingClassGroups:         config.ConflictingClassGroups,
+		conflictingClassGroups:         conflictingClassGroups,
 		conflictingClassGroupModifiers: config.ConflictingClassGroupModifiers,
 	}
 }
@@ -136,32 +142,48 @@ func getGroupIDForArbitraryProperty(className string) string {
 }
 
 // CreateClassMap builds a trie of class part nodes from the config.
+// Validators of different groups sharing a trie node are tried in the order
+// of classGroupPrecedence.
 func CreateClassMap(config *Config) *ClassPartNode {
 	classMap := newClassPartNode()
 
-	// Sort class group keys to ensure deterministic validator ordering on
-	// shared trie nodes. The JS reference relies on object insertion order
-	// where groups with specific validators (e.g. "stroke-w" with isNumber,
-	// isArbitraryLength) are defined before groups with catch-all validators
-	// (e.g. "stroke" with scaleColor/isAny). We replicate this by processing
-	// groups that contain a "color" theme getter (which resolves to isAny)
-	// after all other groups. Within each tier, shorter keys come first.
+	for _, classGroupID := range classGroupPrecedence(config) {
+		processClassesRecursively(config.ClassGroups[classGroupID], classMap, classGroupID, config.Theme)
+	}
+
+	if config.OnValidatorOverlap != nil {
+		for _, overlap := range findValidatorOverlaps(classMap) {
+			config.OnValidatorOverlap(overlap)
+		}
+	}
+
+	return classMap
+}
+
+// classGroupPrecedence returns the class group IDs in the order their
+// validators are tried. Groups with a higher ClassGroupPriority come first.
+// Groups without an explicit priority default to 0, or to -1 if they contain
+// a "color" theme getter: in the default theme it resolves to the IsAny
+// catch-all, which would otherwise shadow specific validators (e.g.
+// "stroke-w" with IsNumber before "stroke" with its colors). This mirrors the
+// JS reference, which relies on object insertion order. Ties are broken by
+// shorter keys first, then alphabetically, so the order is deterministic.
+func classGroupPrecedence(config *Config) []string {
 	keys := make([]string, 0, len(config.ClassGroups))
+	priorities := make(map[string]int, len(config.ClassGroups))
 	for k := range config.ClassGroups {
 		keys = append(keys, k)
-	}
 
-	// Identify class groups that include a color theme getter (catch-all).
-	hasColorTheme := make(map[string]bool, len(keys))
-	for _, k := range keys {
-		hasColorTheme[k] = classGroupHasColorTheme(config.ClassGroups[k])
+		if priority, ok := config.ClassGroupPriority[k]; ok {
+			priorities[k] = priority
+		} else if classGroupHasColorTheme(config.ClassGroups[k]) {
+			priorities[k] = -1
+		}
 	}
 
 	sort.Slice(keys, func(i, j int) bool {
-		iColor := hasColorTheme[keys[i]]
-		jColor := hasColorTheme[keys[j]]
-		if iColor != jColor {
-			return !iColor // non-color groups first
+		if priorities[keys[i]] != priorities[keys[j]] {
+			return priorities[keys[i]] > priorities[keys[j]]
 		}
 		if len(keys[i]) != len(keys[j]) {
 			return len(keys[i]) < len(keys[j])
@@ -169,11 +191,7 @@ func CreateClassMap(config *Config) *ClassPartNode {
 		return keys[i] < keys[j]
 	})
 
-	for _, classGroupID := range keys {
-		processClassesRecursively(config.ClassGroups[classGroupID], classMap, classGroupID, config.Theme)
-	}
-
-	return classMap
+	return keys
 }
 
 // classGroupHasColorTheme returns true if the class group definitions include
@@ -181,15 +199,21 @@ func CreateClassMap(config *Config) *ClassPartNode {
 // validator. Such groups must be processed after groups with specific validators
 // to avoid the catch-all matching values intended for other groups.
 func classGroupHasColorTheme(defs []ClassDefinition) bool {
+	return classGroupHasTheme(defs, "color")
+}
+
+// classGroupHasTheme returns true if the class group definitions include a
+// ThemeGetter with the given key, at any depth.
+func classGroupHasTheme(defs []ClassDefinition, key string) bool {
 	for _, def := range defs {
 		switch v := def.(type) {
 		case ThemeGetter:
-			if v.Key == "color" {
+			if v.Key == key {
 				return true
 			}
 		case map[string][]ClassDefinition:
 			for _, subDefs := range v {
-				if classGroupHasColorTheme(subDefs) {
+				if classGroupHasTheme(subDefs, key) {
 					return true
 				}
 			}
@@ -4,10 +4,21 @@ package twmerge
 // wired together from a single Config.
 type ConfigUtils struct {
 	Cache                      *LRUCache
+	ExpandClassList            func(string) string
+	NormalizeClassList         func([]string) []string
+	OptimizeClassList          func([]string) []string
+	SortClassList              func([]string) []string
 	ParseClassName             func(string) ParsedClassName
 	SortModifiers              func([]string) []string
 	GetClassGroupID            func(string) string
 	GetConflictingClassGroupIDs func(string, bool) []string
+	IsKnownVariant             func(string) bool
+	ParseVariant               func(string) Variant
+
+	config            *Config
+	prefixes          []prefixUtils
+	validateClassName func(string) *ClassNameError
+	allowClass        func(className string, classGroupID string) bool // nil without an allowlist
 }
 
 // CreateConfigUtils creates all utilities from the given config.
@@ -16,12 +27,66 @@ func CreateConfigUtils(config *Config) *ConfigUtils {
 	parseClassName := CreateParseClassName(config)
 	sortModifiers := CreateSortModifiers(config)
 	classGroupUtils := CreateClassGroupUtils(config)
+	getClassGroupID := withCSSVariableLabels(config.CSSVariables, classGroupUtils.GetClassGroupID)
+	isKnownVariant := createIsKnownVariant(config)
 
-	return &ConfigUtils{
+	utils := &ConfigUtils{
 		Cache:                       cache,
+		ExpandClassList:             CreateExpandClassList(config, parseClassName, getClassGroupID),
 		ParseClassName:              parseClassName,
 		SortModifiers:               sortModifiers,
-		GetClassGroupID:             classGroupUtils.GetClassGroupID,
+		GetClassGroupID:             getClassGroupID,
 		GetConflictingClassGroupIDs: classGroupUtils.GetConflictingClassGroupIDs,
+		IsKnownVariant:              isKnownVariant,
+		ParseVariant:                createParseVariant(config),
+		config:                      config,
+		validateClassName:           createValidateClassName(config),
+		allowClass:                  createAllowClass(config, parseClassName, isKnownVariant),
+	}
+
+	if len(config.Prefixes) > 0 {
+		withPrefixes(config, utils)
+	}
+
+	if config.NormalizeImportantModifier {
+		utils.NormalizeClassList = createNormalizeImportantModifiers(config, utils)
+	}
+
+	if config.Optimize {
+		utils.OptimizeClassList = createOptimizeClassList(config, utils)
+	}
+
+	if config.SortClasses {
+		utils.SortClassList = createSortClassList(config, utils)
+	}
+
+	return utils
+}
+
+// CreateExpandClassList creates the pre-pass that rewrites a class list
+// before it is merged, according to the config. Without any expansion
+// enabled, the class list is returned unchanged.
+func CreateExpandClassList(config *Config, parseClassName func(string) ParsedClassName, getClassGroupID func(string) string) func(string) string {
+	var stages []func(string) string
+
+	if config.VariantGroups {
+		stages = append(stages, func(classList string) string {
+			return expandVariantGroups(classList, separatorOf(config))
+		})
+	}
+
+	if len(config.Shortcuts) > 0 {
+		stages = append(stages, createExpandShortcuts(config, parseClassName))
+	}
+
+	if config.CanonicalizeArbitraryValues {
+		stages = append(stages, createCanonicalizeArbitraryValues(config, parseClassName, getClassGroupID))
+	}
+
+	return func(classList string) string {
+		for _, stage := range stages {
+			classList = stage(classList)
+		}
+		return classList
 	}
 }
@@ -13,6 +13,41 @@ func TestContentUtilities(t *testing.T) {
 			classes: "content-['hello'] content-[attr(data-content)]",
 			want:    "content-[attr(data-content)]",
 		},
+		{
+			name:    "colon in quoted content",
+			classes: "content-['a:b'] content-['c:d']",
+			want:    "content-['c:d']",
+		},
+		{
+			name:    "modifier inside quoted content is not a variant",
+			classes: "after:content-['hover:x'] after:content-['y']",
+			want:    "after:content-['y']",
+		},
+		{
+			name:    "closing bracket in quoted content",
+			classes: "before:content-['a]:b'] before:content-['c']",
+			want:    "before:content-['c']",
+		},
+		{
+			name:    "escaped quote in content",
+			classes: `content-['it\'s:here'] content-none`,
+			want:    "content-none",
+		},
+		{
+			name:    "literal space in quoted content",
+			classes: "content-['a b'] p-2 content-['c d']",
+			want:    "p-2 content-['c d']",
+		},
+		{
+			name:    "literal space in double quoted content",
+			classes: `hover:content-["a b"] hover:content-none`,
+			want:    "hover:content-none",
+		},
+		{
+			name:    "unclosed quote does not swallow the class list",
+			classes: "content-['a b p-2 p-4",
+			want:    "content-['a b p-4",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,129 @@
+package twmerge
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	variableShorthandRegex = regexp.MustCompile(`^(.+-)\((--[\w-]+)\)$`)
+	variableArbitraryRegex = regexp.MustCompile(`^(.+-)\[var\((--[\w-]+)\)\]$`)
+	hexColorRegex          = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3,4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+)
+
+// themeNamespaceLabels maps Tailwind CSS v4 theme variable namespaces to the
+// label of their values. Longer namespaces come first so "--font-weight-"
+// wins over "--font-".
+var themeNamespaceLabels = []struct {
+	namespace string
+	label     string
+}{
+	{"--color-", "color"},
+	{"--font-weight-", "weight"},
+	{"--font-", "family-name"},
+	{"--text-shadow-", "shadow"},
+	{"--inset-shadow-", "shadow"},
+	{"--drop-shadow-", "shadow"},
+	{"--shadow-", "shadow"},
+	{"--text-", "length"},
+	{"--tracking-", "length"},
+	{"--leading-", "length"},
+	{"--breakpoint-", "length"},
+	{"--container-", "length"},
+	{"--spacing", "length"},
+	{"--radius-", "length"},
+	{"--blur-", "length"},
+	{"--perspective-", "length"},
+}
+
+// withCSSVariableLabels wraps getClassGroupID so unlabeled variable values
+// like "text-(--brand)" or "bg-[var(--hero)]" are looked up with the label
+// of the registered variable, as if written "text-(color:--brand)". Classes
+// the label does not resolve fall back to the unlabeled lookup.
+func withCSSVariableLabels(cssVariables map[string]string, getClassGroupID func(string) string) func(string) string {
+	if len(cssVariables) == 0 {
+		return getClassGroupID
+	}
+
+	return func(className string) string {
+		var labeled string
+		if match := variableShorthandRegex.FindStringSubmatch(className); match != nil {
+			if label, ok := cssVariables[match[2]]; ok {
+				labeled = match[1] + "(" + label + ":" + match[2] + ")"
+			}
+		} else if match := variableArbitraryRegex.FindStringSubmatch(className); match != nil {
+			if label, ok := cssVariables[match[2]]; ok {
+				labeled = match[1] + "[" + label + ":var(" + match[2] + ")]"
+			}
+		}
+
+		if labeled != "" {
+			if classGroupID := getClassGroupID(labeled); classGroupID != "" {
+				return classGroupID
+			}
+		}
+
+		return getClassGroupID(className)
+	}
+}
+
+// CSSVariablesFromTheme extracts the custom properties defined in the @theme
+// blocks of a Tailwind CSS v4 stylesheet, mapped to the label of their type,
+// for use as Config.CSSVariables:
+//
+//	@theme { --color-brand: #7c3aed; --text-huge: 4rem; --hero: url(/hero.jpg); }
+//
+// maps --color-brand to "color", --text-huge to "length" and --hero to
+// "image". The type follows the theme namespace, or else the value. Variables
+// of unknown type are skipped.
+func CSSVariablesFromTheme(css string) (map[string]string, error) {
+	blocks, err := parseCSSBlocks(cssCommentRegex.ReplaceAllString(css, ""))
+	if err != nil {
+		return nil, err
+	}
+
+	variables := make(map[string]string)
+	for _, block := range blocks {
+		if block.prelude != "@theme" && !strings.HasPrefix(block.prelude, "@theme ") {
+			continue
+		}
+
+		for _, declaration := range strings.Split(block.body, ";") {
+			name, value, ok := strings.Cut(declaration, ":")
+			name = strings.TrimSpace(name)
+			value = strings.TrimSpace(value)
+			if !ok || !strings.HasPrefix(name, "--") || strings.HasSuffix(name, "*") || value == "initial" {
+				continue
+			}
+
+			if label := cssVariableLabel(name, value); label != "" {
+				variables[name] = label
+			}
+		}
+	}
+
+	return variables, nil
+}
+
+// cssVariableLabel returns the arbitrary value label matching a variable, or
+// "" if its type cannot be told.
+func cssVariableLabel(name string, value string) string {
+	for _, namespace := range themeNamespaceLabels {
+		if strings.HasPrefix(name, namespace.namespace) {
+			return namespace.label
+		}
+	}
+
+	switch {
+	case isImage(value):
+		return "image"
+	case hexColorRegex.MatchString(value) || colorFunctionRegex.MatchString(value):
+		return "color"
+	case isLengthOnly(value):
+		return "length"
+	case IsNumber(value):
+		return "number"
+	}
+
+	return ""
+}
@@ -0,0 +1,141 @@
+package twmerge
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCSSVariables(t *testing.T) {
+	merge := CreateTailwindMerge(func() *Config {
+		config := GetDefaultConfig()
+		config.CSSVariables = map[string]string{
+			"--text-huge":   "length",
+			"--brand":       "color",
+			"--hero":        "image",
+			"--glow":        "color",
+			"--font-accent": "family-name",
+		}
+		return config
+	})
+
+	tests := []struct {
+		name    string
+		classes string
+		want    string
+	}{
+		{
+			name:    "length variable is a font size",
+			classes: "text-lg text-red-500 text-(--text-huge)",
+			want:    "text-red-500 text-(--text-huge)",
+		},
+		{
+			name:    "color variable is a text color",
+			classes: "text-lg text-red-500 text-(--brand)",
+			want:    "text-lg text-(--brand)",
+		},
+		{
+			name:    "image variable in arbitrary value",
+			classes: "bg-red-500 bg-[var(--hero)]",
+			want:    "bg-red-500 bg-[var(--hero)]",
+		},
+		{
+			name:    "image variable conflicts with images",
+			classes: "bg-linear-to-r bg-(--hero)",
+			want:    "bg-(--hero)",
+		},
+		{
+			name:    "color variable is a shadow color",
+			classes: "shadow-lg shadow-red-500 shadow-(--glow)",
+			want:    "shadow-lg shadow-(--glow)",
+		},
+		{
+			name:    "family name variable",
+			classes: "font-bold font-sans font-(--font-accent)",
+			want:    "font-bold font-(--font-accent)",
+		},
+		{
+			name:    "unusable label falls back to unlabeled lookup",
+			classes: "font-bold font-(--brand)",
+			want:    "font-(--brand)",
+		},
+		{
+			name:    "explicit label wins",
+			classes: "text-red-500 text-(length:--brand)",
+			want:    "text-red-500 text-(length:--brand)",
+		},
+		{
+			name:    "unknown variables keep default behavior",
+			classes: "text-lg text-(--other) bg-red-500 bg-[var(--other)]",
+			want:    "text-lg text-(--other) bg-[var(--other)]",
+		},
+		{
+			name:    "modifiers and postfix",
+			classes: "hover:text-lg hover:text-(--text-huge)/7",
+			want:    "hover:text-(--text-huge)/7",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := merge(tt.classes); got != tt.want {
+				t.Errorf("merge(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCSSVariablesFromTheme(t *testing.T) {
+	css := `
+@import "tailwindcss";
+
+/* Brand tokens */
+@theme {
+	--color-*: initial;
+	--color-brand: oklch(0.55 0.2 290);
+	--text-huge: 4rem;
+	--text-huge--line-height: 1;
+	--font-display: "Satoshi", sans-serif;
+	--font-weight-heavy: 850;
+	--shadow-glow: 0 0 8px var(--color-brand);
+	--hero: url(/hero.jpg);
+	--accent: #7c3aed;
+	--gutter: 1.5rem;
+	--columns: 12;
+	--easing: cubic-bezier(0.2, 0, 0, 1);
+}
+
+@theme inline {
+	--color-surface: var(--surface);
+}
+
+:root {
+	--surface: white;
+}
+`
+	got, err := CSSVariablesFromTheme(css)
+	if err != nil {
+		t.Fatalf("CSSVariablesFromTheme() error = %v", err)
+	}
+
+	want := map[string]string{
+		"--color-brand":            "color",
+		"--text-huge":              "length",
+		"--text-huge--line-height": "length",
+		"--font-display":           "family-name",
+		"--font-weight-heavy":      "weight",
+		"--shadow-glow":            "shadow",
+		"--hero":                   "image",
+		"--accent":                 "color",
+		"--gutter":                 "length",
+		"--columns":                "number",
+		"--color-surface":          "color",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CSSVariablesFromTheme() = %v, want %v", got, want)
+	}
+}
+
+func TestCSSVariablesFromTheme_Unbalanced(t *testing.T) {
+	if _, err := CSSVariablesFromTheme("@theme { --color-brand: red;"); err == nil {
+		t.Error("CSSVariablesFromTheme() error = nil, want error")
+	}
+}
@@ -699,6 +699,513 @@ func GetDefaultConfig() *Config {
 			"font-size": {"leading"},
 		},
 
+		// "mask-image" accepts any arbitrary value, so it must come after
+		// "mask-position" and "mask-size" like in the JS reference.
+		ClassGroupPriority: map[string]int{
+			"mask-image": -1,
+		},
+
+		Breakpoints: []string{"sm", "md", "lg", "xl", "2xl"},
+
+		// The default --spacing of Tailwind CSS v4, in rem.
+		SpacingUnit: 0.25,
+
+		// Class groups in the order of this file, used to sort merged classes.
+		ClassGroupOrder: []string{
+			// Layout
+			"aspect", "container", "columns", "break-after", "break-before", "break-inside",
+			"box-decoration", "box", "display", "sr", "float", "clear", "isolation", "object-fit",
+			"object-position", "overflow", "overflow-x", "overflow-y", "overscroll",
+			"overscroll-x", "overscroll-y", "position", "inset", "inset-x", "inset-y", "start",
+			"end", "inset-bs", "inset-be", "top", "right", "bottom", "left", "visibility", "z",
+
+			// Flexbox and Grid
+			"basis", "flex-direction", "flex-wrap", "flex", "grow", "shrink", "order", "grid-cols",
+			"col-start-end", "col-start", "col-end", "grid-rows", "row-start-end", "row-start",
+			"row-end", "grid-flow", "auto-cols", "auto-rows", "gap", "gap-x", "gap-y",
+			"justify-content", "justify-items", "justify-self", "align-content", "align-items",
+			"align-self", "place-content", "place-items", "place-self",
+
+			// Spacing
+			"p", "px", "py", "ps", "pe", "pbs", "pbe", "pt", "pr", "pb", "pl", "m", "mx", "my",
+			"ms", "me", "mbs", "mbe", "mt", "mr", "mb", "ml", "space-x", "space-x-reverse",
+			"space-y", "space-y-reverse",
+
+			// Sizing
+			"size", "inline-size", "min-inline-size", "max-inline-size", "block-size",
+			"min-block-size", "max-block-size", "w", "min-w", "max-w", "h", "min-h", "max-h",
+
+			// Typography
+			"font-size", "font-smoothing", "font-style", "font-weight", "font-stretch",
+			"font-family", "font-features", "fvn-normal", "fvn-ordinal", "fvn-slashed-zero",
+			"fvn-figure", "fvn-spacing", "fvn-fraction", "tracking", "line-clamp", "leading",
+			"list-image", "list-style-position", "list-style-type", "text-alignment",
+			"placeholder-color", "text-color", "text-decoration", "text-decoration-style",
+			"text-decoration-thickness", "text-decoration-color", "underline-offset",
+			"text-transform", "text-overflow", "text-wrap", "indent", "vertical-align",
+			"whitespace", "break", "wrap", "hyphens", "content",
+
+			// Backgrounds
+			"bg-attachment", "bg-clip", "bg-origin", "bg-position", "bg-repeat", "bg-size",
+			"bg-image", "bg-color", "gradient-from-pos", "gradient-via-pos", "gradient-to-pos",
+			"gradient-from", "gradient-via", "gradient-to",
+
+			// Borders
+			"rounded", "rounded-s", "rounded-e", "rounded-t", "rounded-r", "rounded-b",
+			"rounded-l", "rounded-ss", "rounded-se", "rounded-ee", "rounded-es", "rounded-tl",
+			"rounded-tr", "rounded-br", "rounded-bl", "border-w", "border-w-x", "border-w-y",
+			"border-w-s", "border-w-e", "border-w-bs", "border-w-be", "border-w-t", "border-w-r",
+			"border-w-b", "border-w-l", "divide-x", "divide-x-reverse", "divide-y",
+			"divide-y-reverse", "border-style", "divide-style", "border-color", "border-color-x",
+			"border-color-y", "border-color-s", "border-color-e", "border-color-bs",
+			"border-color-be", "border-color-t", "border-color-r", "border-color-b",
+			"border-color-l", "divide-color", "outline-style", "outline-offset", "outline-w",
+			"outline-color",
+
+			// Effects
+			"shadow", "shadow-color", "inset-shadow", "inset-shadow-color", "ring-w",
+			"ring-w-inset", "ring-color", "ring-offset-w", "ring-offset-color", "inset-ring-w",
+			"inset-ring-color", "text-shadow", "text-shadow-color", "opacity", "mix-blend",
+			"bg-blend",
+
+			// Masks
+			"mask-clip", "mask-composite", "mask-image-linear-pos", "mask-image-linear-from-pos",
+			"mask-image-linear-to-pos", "mask-image-linear-from-color",
+			"mask-image-linear-to-color", "mask-image-t-from-pos", "mask-image-t-to-pos",
+			"mask-image-t-from-color", "mask-image-t-to-color", "mask-image-r-from-pos",
+			"mask-image-r-to-pos", "mask-image-r-from-color", "mask-image-r-to-color",
+			"mask-image-b-from-pos", "mask-image-b-to-pos", "mask-image-b-from-color",
+			"mask-image-b-to-color", "mask-image-l-from-pos", "mask-image-l-to-pos",
+			"mask-image-l-from-color", "mask-image-l-to-color", "mask-image-x-from-pos",
+			"mask-image-x-to-pos", "mask-image-x-from-color", "mask-image-x-to-color",
+			"mask-image-y-from-pos", "mask-image-y-to-pos", "mask-image-y-from-color",
+			"mask-image-y-to-color", "mask-image-radial", "mask-image-radial-from-pos",
+			"mask-image-radial-to-pos", "mask-image-radial-from-color",
+			"mask-image-radial-to-color", "mask-image-radial-shape", "mask-image-radial-size",
+			"mask-image-radial-pos", "mask-image-conic-pos", "mask-image-conic-from-pos",
+			"mask-image-conic-to-pos", "mask-image-conic-from-color", "mask-image-conic-to-color",
+			"mask-mode", "mask-origin", "mask-position", "mask-repeat", "mask-size", "mask-type",
+			"mask-image",
+
+			// Filters
+			"filter", "blur", "brightness", "contrast", "drop-shadow", "drop-shadow-color",
+			"grayscale", "hue-rotate", "invert", "saturate", "sepia", "backdrop-filter",
+			"backdrop-blur", "backdrop-brightness", "backdrop-contrast", "backdrop-grayscale",
+			"backdrop-hue-rotate", "backdrop-invert", "backdrop-opacity", "backdrop-saturate",
+			"backdrop-sepia",
+
+			// Tables
+			"border-collapse", "border-spacing", "border-spacing-x", "border-spacing-y",
+			"table-layout", "caption",
+
+			// Transitions and Animation
+			"transition", "transition-behavior", "duration", "ease", "delay", "animate",
+
+			// Transforms
+			"backface", "perspective", "perspective-origin", "rotate", "rotate-x", "rotate-y",
+			"rotate-z", "scale", "scale-x", "scale-y", "scale-z", "scale-3d", "skew", "skew-x",
+			"skew-y", "transform", "transform-origin", "transform-style", "translate",
+			"translate-x", "translate-y", "translate-z", "translate-none",
+
+			// Interactivity
+			"accent", "appearance", "caret-color", "color-scheme", "cursor", "field-sizing",
+			"pointer-events", "resize", "scroll-behavior", "scroll-m", "scroll-mx", "scroll-my",
+			"scroll-ms", "scroll-me", "scroll-mbs", "scroll-mbe", "scroll-mt", "scroll-mr",
+			"scroll-mb", "scroll-ml", "scroll-p", "scroll-px", "scroll-py", "scroll-ps",
+			"scroll-pe", "scroll-pbs", "scroll-pbe", "scroll-pt", "scroll-pr", "scroll-pb",
+			"scroll-pl", "snap-align", "snap-stop", "snap-type", "snap-strictness", "touch",
+			"touch-x", "touch-y", "touch-pz", "select", "will-change",
+
+			// SVG
+			"fill", "stroke-w", "stroke",
+
+			// Accessibility
+			"forced-color-adjust",
+		},
+
+		// Variants in the order Tailwind CSS registers them. Entries ending in
+		// "*" also match other variants starting with what precedes it.
+		VariantOrder: []string{
+			"*", "**", "not-*", "group-*", "peer-*",
+			"first-letter", "first-line", "marker", "selection", "file", "placeholder", "backdrop",
+			"details-content", "before", "after",
+			"first", "last", "only", "odd", "even", "first-of-type", "last-of-type", "only-of-type",
+			"visited", "target", "open", "default", "checked", "indeterminate", "placeholder-shown",
+			"autofill", "optional", "required", "valid", "invalid", "user-valid", "user-invalid",
+			"in-range", "out-of-range", "read-only", "empty", "focus-within",
+			"hover", "focus", "focus-visible", "active", "enabled", "disabled", "inert",
+			"in-*", "has-*", "aria-*", "data-*", "nth-*", "nth-last-*", "nth-of-type-*", "nth-last-of-type-*",
+			"supports-*", "motion-safe", "motion-reduce", "contrast-more", "contrast-less",
+			"max-*", "sm", "md", "lg", "xl", "2xl", "min-*", "@max-*", "@*",
+			"portrait", "landscape", "ltr", "rtl", "dark", "starting", "print",
+			"forced-colors", "inverted-colors", "pointer-*", "any-pointer-*", "noscript",
+		},
+
+		Variants: []string{
+			"*", "**",
+			"first-letter", "first-line", "marker", "selection", "file", "placeholder", "backdrop",
+			"details-content", "before", "after",
+			"first", "last", "only", "odd", "even", "first-of-type", "last-of-type", "only-of-type",
+			"visited", "target", "open", "default", "checked", "indeterminate", "placeholder-shown",
+			"autofill", "optional", "required", "valid", "invalid", "user-valid", "user-invalid",
+			"in-range", "out-of-range", "read-only", "empty", "focus-within",
+			"hover", "focus", "focus-visible", "active", "enabled", "disabled", "inert",
+			"motion-safe", "motion-reduce", "contrast-more", "contrast-less",
+			"portrait", "landscape", "ltr", "rtl", "dark", "starting", "print",
+			"forced-colors", "inverted-colors", "noscript",
+			"pointer-fine", "pointer-coarse", "pointer-none",
+			"any-pointer-fine", "any-pointer-coarse", "any-pointer-none",
+		},
+
+		FunctionalVariants: map[string]VariantValue{
+			"not":   VariantValueVariant,
+			"group": VariantValueVariant,
+			"peer":  VariantValueVariant,
+			"in":    VariantValueVariant,
+			"has":   VariantValueVariant,
+
+			"aria":             VariantValueAny,
+			"data":             VariantValueAny,
+			"nth":              VariantValueAny,
+			"nth-last":         VariantValueAny,
+			"nth-of-type":      VariantValueAny,
+			"nth-last-of-type": VariantValueAny,
+			"supports":         VariantValueAny,
+
+			"max": VariantValueBreakpoint,
+			"min": VariantValueBreakpoint,
+
+			"@":    VariantValueContainer,
+			"@max": VariantValueContainer,
+			"@min": VariantValueContainer,
+		},
+
+		VariantAliases: map[string]string{
+			// Breakpoints are min-width queries
+			"min-sm":  "sm",
+			"min-md":  "md",
+			"min-lg":  "lg",
+			"min-xl":  "xl",
+			"min-2xl": "2xl",
+
+			// Container query sizes are min-width queries
+			"@min-3xs": "@3xs",
+			"@min-2xs": "@2xs",
+			"@min-xs":  "@xs",
+			"@min-sm":  "@sm",
+			"@min-md":  "@md",
+			"@min-lg":  "@lg",
+			"@min-xl":  "@xl",
+			"@min-2xl": "@2xl",
+			"@min-3xl": "@3xl",
+			"@min-4xl": "@4xl",
+			"@min-5xl": "@5xl",
+			"@min-6xl": "@6xl",
+			"@min-7xl": "@7xl",
+
+			// Arbitrary variants matching a built-in variant exactly
+			"[&:first-child]":                     "first",
+			"[&:last-child]":                      "last",
+			"[&:only-child]":                      "only",
+			"[&:nth-child(odd)]":                  "odd",
+			"[&:nth-child(even)]":                 "even",
+			"[&:focus]":                           "focus",
+			"[&:focus-visible]":                   "focus-visible",
+			"[&:focus-within]":                    "focus-within",
+			"[&:active]":                          "active",
+			"[&:visited]":                         "visited",
+			"[&:disabled]":                        "disabled",
+			"[&:enabled]":                         "enabled",
+			"[&:checked]":                         "checked",
+			"[&:required]":                        "required",
+			"[&:invalid]":                         "invalid",
+			"[&:empty]":                           "empty",
+			"[@media(prefers-color-scheme:dark)]": "dark",
+			"[@media_print]":                      "print",
+		},
+		ArbitraryPropertyClassGroups: map[string][]string{
+			// Layout
+			"aspect-ratio":          {"aspect"},
+			"columns":               {"columns"},
+			"break-after":           {"break-after"},
+			"break-before":          {"break-before"},
+			"break-inside":          {"break-inside"},
+			"box-decoration-break":  {"box-decoration"},
+			"box-sizing":            {"box"},
+			"display":               {"display"},
+			"float":                 {"float"},
+			"clear":                 {"clear"},
+			"isolation":             {"isolation"},
+			"object-fit":            {"object-fit"},
+			"object-position":       {"object-position"},
+			"overflow":              {"overflow"},
+			"overflow-x":            {"overflow-x"},
+			"overflow-y":            {"overflow-y"},
+			"overscroll-behavior":   {"overscroll"},
+			"overscroll-behavior-x": {"overscroll-x"},
+			"overscroll-behavior-y": {"overscroll-y"},
+			"position":              {"position"},
+			"inset":                 {"inset"},
+			"inset-inline":          {"inset-x"},
+			"inset-block":           {"inset-y"},
+			"inset-inline-start":    {"start"},
+			"inset-inline-end":      {"end"},
+			"inset-block-start":     {"inset-bs"},
+			"inset-block-end":       {"inset-be"},
+			"top":                   {"top"},
+			"right":                 {"right"},
+			"bottom":                {"bottom"},
+			"left":                  {"left"},
+			"visibility":            {"visibility"},
+			"z-index":               {"z"},
+
+			// Flexbox and Grid
+			"flex-basis":            {"basis"},
+			"flex-direction":        {"flex-direction"},
+			"flex-wrap":             {"flex-wrap"},
+			"flex":                  {"flex"},
+			"flex-grow":             {"grow"},
+			"flex-shrink":           {"shrink"},
+			"order":                 {"order"},
+			"grid-template-columns": {"grid-cols"},
+			"grid-column":           {"col-start-end"},
+			"grid-column-start":     {"col-start"},
+			"grid-column-end":       {"col-end"},
+			"grid-template-rows":    {"grid-rows"},
+			"grid-row":              {"row-start-end"},
+			"grid-row-start":        {"row-start"},
+			"grid-row-end":          {"row-end"},
+			"grid-auto-flow":        {"grid-flow"},
+			"grid-auto-columns":     {"auto-cols"},
+			"grid-auto-rows":        {"auto-rows"},
+			"gap":                   {"gap"},
+			"column-gap":            {"gap-x"},
+			"row-gap":               {"gap-y"},
+			"justify-content":       {"justify-content"},
+			"justify-items":         {"justify-items"},
+			"justify-self":          {"justify-self"},
+			"align-content":         {"align-content"},
+			"align-items":           {"align-items"},
+			"align-self":            {"align-self"},
+			"place-content":         {"place-content"},
+			"place-items":           {"place-items"},
+			"place-self":            {"place-self"},
+
+			// Spacing
+			"padding":              {"p"},
+			"padding-inline":       {"px"},
+			"padding-block":        {"py"},
+			"padding-inline-start": {"ps"},
+			"padding-inline-end":   {"pe"},
+			"padding-block-start":  {"pbs"},
+			"padding-block-end":    {"pbe"},
+			"padding-top":          {"pt"},
+			"padding-right":        {"pr"},
+			"padding-bottom":       {"pb"},
+			"padding-left":         {"pl"},
+			"margin":               {"m"},
+			"margin-inline":        {"mx"},
+			"margin-block":         {"my"},
+			"margin-inline-start":  {"ms"},
+			"margin-inline-end":    {"me"},
+			"margin-block-start":   {"mbs"},
+			"margin-block-end":     {"mbe"},
+			"margin-top":           {"mt"},
+			"margin-right":         {"mr"},
+			"margin-bottom":        {"mb"},
+			"margin-left":          {"ml"},
+
+			// Sizing
+			"width":           {"w"},
+			"min-width":       {"min-w"},
+			"max-width":       {"max-w"},
+			"height":          {"h"},
+			"min-height":      {"min-h"},
+			"max-height":      {"max-h"},
+			"inline-size":     {"inline-size"},
+			"min-inline-size": {"min-inline-size"},
+			"max-inline-size": {"max-inline-size"},
+			"block-size":      {"block-size"},
+			"min-block-size":  {"min-block-size"},
+			"max-block-size":  {"max-block-size"},
+
+			// Typography
+			"font-size":                 {"font-size"},
+			"-webkit-font-smoothing":    {"font-smoothing"},
+			"font-style":                {"font-style"},
+			"font-weight":               {"font-weight"},
+			"font-stretch":              {"font-stretch"},
+			"font-family":               {"font-family"},
+			"font-feature-settings":     {"font-features"},
+			"letter-spacing":            {"tracking"},
+			"line-height":               {"leading"},
+			"list-style-image":          {"list-image"},
+			"list-style-position":       {"list-style-position"},
+			"list-style-type":           {"list-style-type"},
+			"text-align":                {"text-alignment"},
+			"color":                     {"text-color"},
+			"text-decoration-line":      {"text-decoration"},
+			"text-decoration-style":     {"text-decoration-style"},
+			"text-decoration-thickness": {"text-decoration-thickness"},
+			"text-decoration-color":     {"text-decoration-color"},
+			"text-underline-offset":     {"underline-offset"},
+			"text-transform":            {"text-transform"},
+			"text-overflow":             {"text-overflow"},
+			"text-wrap":                 {"text-wrap"},
+			"text-indent":               {"indent"},
+			"vertical-align":            {"vertical-align"},
+			"white-space":               {"whitespace"},
+			"word-break":                {"break"},
+			"overflow-wrap":             {"wrap"},
+			"hyphens":                   {"hyphens"},
+			"content":                   {"content"},
+
+			// Backgrounds
+			"background-attachment": {"bg-attachment"},
+			"background-clip":       {"bg-clip"},
+			"background-origin":     {"bg-origin"},
+			"background-position":   {"bg-position"},
+			"background-repeat":     {"bg-repeat"},
+			"background-size":       {"bg-size"},
+			"background-image":      {"bg-image"},
+			"background-color":      {"bg-color"},
+
+			// Borders
+			"border-radius":              {"rounded"},
+			"border-start-start-radius":  {"rounded-ss"},
+			"border-start-end-radius":    {"rounded-se"},
+			"border-end-end-radius":      {"rounded-ee"},
+			"border-end-start-radius":    {"rounded-es"},
+			"border-top-left-radius":     {"rounded-tl"},
+			"border-top-right-radius":    {"rounded-tr"},
+			"border-bottom-right-radius": {"rounded-br"},
+			"border-bottom-left-radius":  {"rounded-bl"},
+			"border-width":               {"border-w"},
+			"border-inline-width":        {"border-w-x"},
+			"border-block-width":         {"border-w-y"},
+			"border-inline-start-width":  {"border-w-s"},
+			"border-inline-end-width":    {"border-w-e"},
+			"border-block-start-width":   {"border-w-bs"},
+			"border-block-end-width":     {"border-w-be"},
+			"border-top-width":           {"border-w-t"},
+			"border-right-width":         {"border-w-r"},
+			"border-bottom-width":        {"border-w-b"},
+			"border-left-width":          {"border-w-l"},
+			"border-style":               {"border-style"},
+			"border-color":               {"border-color"},
+			"border-inline-color":        {"border-color-x"},
+			"border-block-color":         {"border-color-y"},
+			"border-inline-start-color":  {"border-color-s"},
+			"border-inline-end-color":    {"border-color-e"},
+			"border-block-start-color":   {"border-color-bs"},
+			"border-block-end-color":     {"border-color-be"},
+			"border-top-color":           {"border-color-t"},
+			"border-right-color":         {"border-color-r"},
+			"border-bottom-color":        {"border-color-b"},
+			"border-left-color":          {"border-color-l"},
+			"outline-style":              {"outline-style"},
+			"outline-offset":             {"outline-offset"},
+			"outline-width":              {"outline-w"},
+			"outline-color":              {"outline-color"},
+
+			// Effects
+			"box-shadow":            {"shadow"},
+			"opacity":               {"opacity"},
+			"mix-blend-mode":        {"mix-blend"},
+			"background-blend-mode": {"bg-blend"},
+
+			// Filters
+			"filter":          {"filter"},
+			"backdrop-filter": {"backdrop-filter"},
+
+			// Tables
+			"border-collapse": {"border-collapse"},
+			"table-layout":    {"table-layout"},
+			"caption-side":    {"caption"},
+
+			// Transitions and Animation
+			"transition-property":        {"transition"},
+			"transition-behavior":        {"transition-behavior"},
+			"transition-duration":        {"duration"},
+			"transition-timing-function": {"ease"},
+			"transition-delay":           {"delay"},
+			"animation":                  {"animate"},
+
+			// Transforms
+			"backface-visibility": {"backface"},
+			"perspective":         {"perspective"},
+			"perspective-origin":  {"perspective-origin"},
+			"rotate":              {"rotate"},
+			"scale":               {"scale"},
+			"transform":           {"transform"},
+			"transform-origin":    {"transform-origin"},
+			"transform-style":     {"transform-style"},
+			"translate":           {"translate"},
+
+			// Interactivity
+			"accent-color":    {"accent"},
+			"appearance":      {"appearance"},
+			"caret-color":     {"caret-color"},
+			"color-scheme":    {"color-scheme"},
+			"cursor":          {"cursor"},
+			"field-sizing":    {"field-sizing"},
+			"pointer-events":  {"pointer-events"},
+			"resize":          {"resize"},
+			"scroll-behavior": {"scroll-behavior"},
+			"touch-action":    {"touch"},
+			"user-select":     {"select"},
+			"will-change":     {"will-change"},
+
+			// SVG
+			"fill":         {"fill"},
+			"stroke":       {"stroke"},
+			"stroke-width": {"stroke-w"},
+
+			// Accessibility
+			"forced-color-adjust": {"forced-color-adjust"},
+		},
+
+		CSSPropertyLonghands: map[string][]string{
+			"overflow":            {"overflow-x", "overflow-y"},
+			"overscroll-behavior": {"overscroll-behavior-x", "overscroll-behavior-y"},
+			"inset":               {"inset-inline", "inset-block", "top", "right", "bottom", "left"},
+			"inset-inline":        {"inset-inline-start", "inset-inline-end", "right", "left"},
+			"inset-block":         {"inset-block-start", "inset-block-end", "top", "bottom"},
+			"flex":                {"flex-grow", "flex-shrink", "flex-basis"},
+			"flex-flow":           {"flex-direction", "flex-wrap"},
+			"grid-column":         {"grid-column-start", "grid-column-end"},
+			"grid-row":            {"grid-row-start", "grid-row-end"},
+			"gap":                 {"row-gap", "column-gap"},
+			"place-content":       {"align-content", "justify-content"},
+			"place-items":         {"align-items", "justify-items"},
+			"place-self":          {"align-self", "justify-self"},
+			"padding":             {"padding-inline", "padding-block", "padding-top", "padding-right", "padding-bottom", "padding-left"},
+			"padding-inline":      {"padding-inline-start", "padding-inline-end", "padding-right", "padding-left"},
+			"padding-block":       {"padding-block-start", "padding-block-end", "padding-top", "padding-bottom"},
+			"margin":              {"margin-inline", "margin-block", "margin-top", "margin-right", "margin-bottom", "margin-left"},
+			"margin-inline":       {"margin-inline-start", "margin-inline-end", "margin-right", "margin-left"},
+			"margin-block":        {"margin-block-start", "margin-block-end", "margin-top", "margin-bottom"},
+			"font":                {"font-style", "font-weight", "font-stretch", "font-size", "line-height", "font-family"},
+			"text-decoration":     {"text-decoration-line", "text-decoration-style", "text-decoration-color", "text-decoration-thickness"},
+			"list-style":          {"list-style-type", "list-style-position", "list-style-image"},
+			"background": {
+				"background-attachment", "background-clip", "background-origin", "background-position",
+				"background-repeat", "background-size", "background-image", "background-color",
+			},
+			"border-radius": {
+				"border-start-start-radius", "border-start-end-radius", "border-end-end-radius", "border-end-start-radius",
+				"border-top-left-radius", "border-top-right-radius", "border-bottom-right-radius", "border-bottom-left-radius",
+			},
+			"border":              {"border-width", "border-style", "border-color"},
+			"border-width":        {"border-inline-width", "border-block-width", "border-top-width", "border-right-width", "border-bottom-width", "border-left-width"},
+			"border-inline-width": {"border-inline-start-width", "border-inline-end-width", "border-right-width", "border-left-width"},
+			"border-block-width":  {"border-block-start-width", "border-block-end-width", "border-top-width", "border-bottom-width"},
+			"border-color":        {"border-inline-color", "border-block-color", "border-top-color", "border-right-color", "border-bottom-color", "border-left-color"},
+			"border-inline-color": {"border-inline-start-color", "border-inline-end-color", "border-right-color", "border-left-color"},
+			"border-block-color":  {"border-block-start-color", "border-block-end-color", "border-top-color", "border-bottom-color"},
+			"outline":             {"outline-width", "outline-style", "outline-color"},
+			"transition":          {"transition-property", "transition-duration", "transition-timing-function", "transition-delay", "transition-behavior"},
+		},
 		OrderSensitiveModifiers: []string{
 			"*", "**", "after", "backdrop", "before", "details-content",
 			"file", "first-letter", "first-line", "marker", "placeholder", "selection",
@@ -0,0 +1,115 @@
+package twmerge
+
+// ClassDiff describes how the effective classes of two class lists differ.
+// Classes are compared per modifier ID and class group, so reordering or
+// overridden classes do not show up as differences.
+type ClassDiff struct {
+	// Added lists the classes of b setting something a does not set.
+	Added []string
+	// Removed lists the classes of a setting something b does not set.
+	Removed []string
+	// Changed lists the classes setting the same thing with a different value.
+	Changed []ClassChange
+}
+
+// ClassChange is a class of one class list replaced in the other.
+type ClassChange struct {
+	From string
+	To   string
+}
+
+// Empty reports whether the class lists have the same effect.
+func (d ClassDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Equivalent reports whether two class lists have the same effect after
+// merging, using the default configuration. For example "p-2 p-4 text-lg"
+// and "text-lg p-4" are equivalent, and so are "hover:focus:p-4" and
+// "focus:hover:p-4". Non-Tailwind classes are compared as a set.
+func Equivalent(a, b string) bool {
+	return diff(a, b, getDefaultConfigUtils()).Empty()
+}
+
+// Diff compares what two class lists effectively set after merging, using
+// the default configuration. Added and Changed follow the order of b,
+// Removed follows the order of a.
+func Diff(a, b string) ClassDiff {
+	return diff(a, b, getDefaultConfigUtils())
+}
+
+// effectiveKey identifies what a merged class sets: its modifier ID and class
+// group, or the class name itself for non-Tailwind classes.
+type effectiveKey struct {
+	external bool
+	id       string
+}
+
+type effectiveClass struct {
+	key       effectiveKey
+	value     string // modifier ID and base class name
+	className string
+}
+
+func diff(a, b string, utils *ConfigUtils) ClassDiff {
+	before := effectiveClassesOf(a, utils)
+	after := effectiveClassesOf(b, utils)
+
+	beforeByKey := make(map[effectiveKey]effectiveClass, len(before))
+	for _, class := range before {
+		beforeByKey[class.key] = class
+	}
+	afterByKey := make(map[effectiveKey]effectiveClass, len(after))
+	for _, class := range after {
+		afterByKey[class.key] = class
+	}
+
+	var result ClassDiff
+	for _, class := range after {
+		previous, ok := beforeByKey[class.key]
+		switch {
+		case !ok:
+			result.Added = append(result.Added, class.className)
+		case previous.value != class.value:
+			result.Changed = append(result.Changed, ClassChange{From: previous.className, To: class.className})
+		}
+	}
+	for _, class := range before {
+		if _, ok := afterByKey[class.key]; !ok {
+			result.Removed = append(result.Removed, class.className)
+		}
+	}
+
+	return result
+}
+
+func effectiveClassesOf(classList string, utils *ConfigUtils) []effectiveClass {
+	var classes []effectiveClass
+
+	for _, className := range splitClassesRegex(MergeClassList(classList, utils)) {
+		classUtils, prefixID := utils.utilsFor(className)
+		parsed := classUtils.ParseClassName(className)
+
+		classGroupID := ""
+		if !parsed.IsExternal {
+			classGroupID, _ = classGroupIDOf(parsed, classUtils)
+		}
+		if classGroupID == "" {
+			classes = append(classes, effectiveClass{
+				key:       effectiveKey{external: true, id: className},
+				value:     className,
+				className: className,
+			})
+			continue
+		}
+
+		modifierID := prefixID + modifierIDOf(parsed, classUtils)
+		classes = append(classes, effectiveClass{
+			key:       effectiveKey{id: modifierID + classGroupID},
+			value:     modifierID + parsed.BaseClassName,
+			className: className,
+		})
+	}
+
+	return classes
+}
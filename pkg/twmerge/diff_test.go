@@ -0,0 +1,144 @@
+package twmerge
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEquivalent(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want bool
+	}{
+		{
+			name: "identical",
+			a:    "p-4 text-lg",
+			b:    "p-4 text-lg",
+			want: true,
+		},
+		{
+			name: "reordered",
+			a:    "p-4 text-lg card",
+			b:    "card text-lg p-4",
+			want: true,
+		},
+		{
+			name: "overridden classes",
+			a:    "p-2 p-4 text-lg",
+			b:    "text-lg p-4",
+			want: true,
+		},
+		{
+			name: "modifier order",
+			a:    "hover:focus:p-4",
+			b:    "focus:hover:p-4",
+			want: true,
+		},
+		{
+			name: "important modifier position",
+			a:    "!p-4",
+			b:    "p-4!",
+			want: true,
+		},
+		{
+			name: "duplicate non-tailwind classes",
+			a:    "card card",
+			b:    "card",
+			want: true,
+		},
+		{
+			name: "different value",
+			a:    "p-4",
+			b:    "p-2",
+			want: false,
+		},
+		{
+			name: "different modifier",
+			a:    "hover:p-4",
+			b:    "focus:p-4",
+			want: false,
+		},
+		{
+			name: "important is not the same",
+			a:    "p-4",
+			b:    "!p-4",
+			want: false,
+		},
+		{
+			name: "missing non-tailwind class",
+			a:    "card p-4",
+			b:    "p-4",
+			want: false,
+		},
+		{
+			name: "shorthand is not the same as longhands",
+			a:    "px-2 py-2",
+			b:    "p-2",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Equivalent(tt.a, tt.b); got != tt.want {
+				t.Errorf("Equivalent(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want ClassDiff
+	}{
+		{
+			name: "no changes",
+			a:    "p-2 p-4 card",
+			b:    "card p-4",
+			want: ClassDiff{},
+		},
+		{
+			name: "changed values",
+			a:    "p-4 text-gray-500 hover:text-gray-700",
+			b:    "p-4 text-slate-500 hover:text-slate-700",
+			want: ClassDiff{
+				Changed: []ClassChange{
+					{From: "text-gray-500", To: "text-slate-500"},
+					{From: "hover:text-gray-700", To: "hover:text-slate-700"},
+				},
+			},
+		},
+		{
+			name: "added and removed",
+			a:    "card p-4 rounded",
+			b:    "p-4 shadow-md panel",
+			want: ClassDiff{
+				Added:   []string{"shadow-md", "panel"},
+				Removed: []string{"card", "rounded"},
+			},
+		},
+		{
+			name: "overridden class is not removed",
+			a:    "p-2",
+			b:    "p-2 p-4",
+			want: ClassDiff{
+				Changed: []ClassChange{{From: "p-2", To: "p-4"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Diff(tt.a, tt.b)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Diff(%q, %q) = %+v, want %+v", tt.a, tt.b, got, tt.want)
+			}
+			if got.Empty() != Equivalent(tt.a, tt.b) {
+				t.Errorf("Diff(%q, %q).Empty() disagrees with Equivalent", tt.a, tt.b)
+			}
+		})
+	}
+}
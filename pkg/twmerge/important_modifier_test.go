@@ -104,3 +104,132 @@ func TestImportantModifierMixedV3V4(t *testing.T) {
 		})
 	}
 }
+
+func TestImportantModifierStyle(t *testing.T) {
+	tests := []struct {
+		name    string
+		style   ImportantModifierStyle
+		classes string
+		want    string
+	}{
+		{
+			name:    "any style recognizes both forms",
+			style:   ImportantModifierAny,
+			classes: "!p-2 p-4!",
+			want:    "p-4!",
+		},
+		{
+			name:    "suffix style leaves leading modifier unrecognized",
+			style:   ImportantModifierSuffix,
+			classes: "p-2! !p-4 p-6!",
+			want:    "!p-4 p-6!",
+		},
+		{
+			name:    "prefix style leaves trailing modifier unrecognized",
+			style:   ImportantModifierPrefix,
+			classes: "!p-2 p-4! !p-6",
+			want:    "p-4! !p-6",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merge := CreateTailwindMerge(func() *Config {
+				config := GetDefaultConfig()
+				config.ImportantModifierStyle = tt.style
+				return config
+			})
+			if got := merge(tt.classes); got != tt.want {
+				t.Errorf("merge(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeImportantModifier(t *testing.T) {
+	merge := CreateTailwindMerge(func() *Config {
+		config := GetDefaultConfig()
+		config.NormalizeImportantModifier = true
+		return config
+	})
+
+	tests := []struct {
+		classes string
+		want    string
+	}{
+		{"!p-4", "p-4!"},
+		{"hover:!p-4 m-2!", "hover:p-4! m-2!"},
+		{"!-mt-2", "-mt-2!"},
+		{"[&:hover]:!bg-[red]", "[&:hover]:bg-[red]!"},
+		{"!p-2 p-4!", "p-4!"},
+		{"!custom p-2", "!custom p-2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.classes, func(t *testing.T) {
+			if got := merge(tt.classes); got != tt.want {
+				t.Errorf("merge(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeImportantModifier_PrefixForm(t *testing.T) {
+	tests := []struct {
+		name      string
+		getConfig func() *Config
+		classes   string
+		want      string
+	}{
+		{
+			name: "prefix style",
+			getConfig: func() *Config {
+				config := GetDefaultConfig()
+				config.ImportantModifierStyle = ImportantModifierPrefix
+				return config
+			},
+			classes: "!p-2 !p-4",
+			want:    "!p-4",
+		},
+		{
+			name: "prefix style keeps conflicts",
+			getConfig: func() *Config {
+				config := GetDefaultConfig()
+				config.ImportantModifierStyle = ImportantModifierPrefix
+				return config
+			},
+			classes: "!p-4 p-2 !px-2",
+			want:    "!p-4 p-2 !px-2",
+		},
+		{
+			name: "v3 preset",
+			getConfig: func() *Config {
+				config := GetV3Config()
+				config.Prefix = "tw-"
+				return config
+			},
+			classes: "tw-p-2! hover:tw-p-4! -tw-mt-2!",
+			want:    "!tw-p-2 hover:!tw-p-4 !-tw-mt-2",
+		},
+		{
+			name: "v3 preset merges both forms",
+			getConfig: func() *Config {
+				config := GetV3Config()
+				config.Prefix = "tw-"
+				return config
+			},
+			classes: "tw-p-2! !tw-p-4",
+			want:    "!tw-p-4",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merge := CreateTailwindMerge(func() *Config {
+				config := tt.getConfig()
+				config.NormalizeImportantModifier = true
+				return config
+			})
+			if got := merge(tt.classes); got != tt.want {
+				t.Errorf("merge(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
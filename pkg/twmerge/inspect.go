@@ -0,0 +1,29 @@
+package twmerge
+
+// ParseClass parses a single class name with the default configuration,
+// exactly as the merger does before resolving conflicts.
+func ParseClass(className string) ParsedClassName {
+	return getDefaultConfigUtils().ParseClassName(className)
+}
+
+// GetClassGroup returns the class group ID of a single class name, including
+// its modifiers, using the default configuration. Returns "" for classes that
+// are not recognized as Tailwind utilities.
+func GetClassGroup(className string) string {
+	utils, _ := getDefaultConfigUtils().utilsFor(className)
+
+	parsed := utils.ParseClassName(className)
+	if parsed.IsExternal {
+		return ""
+	}
+
+	classGroupID, _ := classGroupIDOf(parsed, utils)
+	return classGroupID
+}
+
+// GetConflictingClassGroups returns the class group IDs that a class of the
+// given group overrides under the default configuration. hasPostfixModifier
+// selects the additional conflicts of classes like "text-lg/7".
+func GetConflictingClassGroups(classGroupID string, hasPostfixModifier bool) []string {
+	return getDefaultConfigUtils().GetConflictingClassGroupIDs(classGroupID, hasPostfixModifier)
+}
@@ -0,0 +1,58 @@
+package twmerge
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseClass(t *testing.T) {
+	got := ParseClass("hover:focus:text-lg/7!")
+	want := ParsedClassName{
+		Modifiers:                    []string{"hover", "focus"},
+		HasImportantModifier:         true,
+		BaseClassName:                "text-lg/7",
+		MaybePostfixModifierPosition: 7,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseClass() = %+v, want %+v", got, want)
+	}
+}
+
+func TestGetClassGroup(t *testing.T) {
+	tests := []struct {
+		className string
+		want      string
+	}{
+		{"p-4", "p"},
+		{"hover:!text-red-500", "text-color"},
+		{"text-lg/7", "font-size"},
+		{"bg-red-500/50", "bg-color"},
+		{"-mt-2", "mt"},
+		{"[padding:1rem]", "arbitrary..padding"},
+		{"my-custom-class", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.className, func(t *testing.T) {
+			if got := GetClassGroup(tt.className); got != tt.want {
+				t.Errorf("GetClassGroup(%q) = %q, want %q", tt.className, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetConflictingClassGroups(t *testing.T) {
+	got := GetConflictingClassGroups("px", false)
+	if len(got) < 2 || got[0] != "pr" || got[1] != "pl" {
+		t.Errorf("GetConflictingClassGroups(px) = %v, want it to start with [pr pl]", got)
+	}
+
+	withoutPostfix := GetConflictingClassGroups("font-size", false)
+	withPostfix := GetConflictingClassGroups("font-size", true)
+	if len(withPostfix) != len(withoutPostfix)+1 {
+		t.Errorf("expected postfix modifier to add leading conflict, got %v and %v", withoutPostfix, withPostfix)
+	}
+
+	if got := GetConflictingClassGroups("unknown-group", false); got != nil {
+		t.Errorf("expected no conflicts for unknown group, got %v", got)
+	}
+}
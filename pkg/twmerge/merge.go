@@ -4,12 +4,12 @@ import (
 	"strings"
 )
 
-var splitClassesRegex = strings.Fields
+var splitClassesRegex = splitClasses
 
 // MergeClassList merges a space-separated class list, resolving conflicts
 // by keeping the last conflicting class (reverse iteration).
 func MergeClassList(classList string, utils *ConfigUtils) string {
-	classNames := splitClassesRegex(strings.TrimSpace(classList))
+	classNames := splitClassesRegex(strings.TrimSpace(utils.ExpandClassList(classList)))
 	if len(classNames) == 0 {
 		return ""
 	}
@@ -21,54 +21,31 @@ func MergeClassList(classList string, utils *ConfigUtils) string {
 	for i := len(classNames) - 1; i >= 0; i-- {
 		originalClassName := classNames[i]
 
-		parsed := utils.ParseClassName(originalClassName)
+		classUtils, prefixID := utils.utilsFor(originalClassName)
+		parsed := classUtils.ParseClassName(originalClassName)
 
 		if parsed.IsExternal {
-			cursor--
-			finalClasses[cursor] = originalClassName
-			continue
-		}
-
-		hasPostfixModifier := parsed.MaybePostfixModifierPosition != -1
-		var classGroupID string
-
-		if hasPostfixModifier {
-			classGroupID = utils.GetClassGroupID(parsed.BaseClassName[:parsed.MaybePostfixModifierPosition])
-		} else {
-			classGroupID = utils.GetClassGroupID(parsed.BaseClassName)
-		}
-
-		if classGroupID == "" {
-			if !hasPostfixModifier {
+			if classUtils.allowClass == nil || classUtils.allowClass(originalClassName, "") {
 				cursor--
 				finalClasses[cursor] = originalClassName
-				continue
 			}
+			continue
+		}
 
-			classGroupID = utils.GetClassGroupID(parsed.BaseClassName)
-			if classGroupID == "" {
+		classGroupID, hasPostfixModifier := classGroupIDOf(parsed, classUtils)
+		if classGroupID == "" {
+			if classUtils.allowClass == nil || classUtils.allowClass(originalClassName, "") {
 				cursor--
 				finalClasses[cursor] = originalClassName
-				continue
 			}
-			hasPostfixModifier = false
-		}
-
-		var variantModifier string
-		if len(parsed.Modifiers) == 0 {
-			variantModifier = ""
-		} else if len(parsed.Modifiers) == 1 {
-			variantModifier = parsed.Modifiers[0]
-		} else {
-			sorted := utils.SortModifiers(parsed.Modifiers)
-			variantModifier = strings.Join(sorted, ":")
+			continue
 		}
 
-		modifierID := variantModifier
-		if parsed.HasImportantModifier {
-			modifierID = variantModifier + ImportantModifier
+		if classUtils.allowClass != nil && !classUtils.allowClass(originalClassName, classGroupID) {
+			continue
 		}
 
+		modifierID := prefixID + modifierIDOf(parsed, classUtils)
 		classID := modifierID + classGroupID
 
 		if _, exists := classGroupsInConflict[classID]; exists {
@@ -77,7 +54,7 @@ func MergeClassList(classList string, utils *ConfigUtils) string {
 
 		classGroupsInConflict[classID] = struct{}{}
 
-		conflictGroups := utils.GetConflictingClassGroupIDs(classGroupID, hasPostfixModifier)
+		conflictGroups := classUtils.GetConflictingClassGroupIDs(classGroupID, hasPostfixModifier)
 		for _, group := range conflictGroups {
 			classGroupsInConflict[modifierID+group] = struct{}{}
 		}
@@ -86,5 +63,53 @@ func MergeClassList(classList string, utils *ConfigUtils) string {
 		finalClasses[cursor] = originalClassName
 	}
 
-	return strings.Join(finalClasses[cursor:], " ")
+	mergedClasses := finalClasses[cursor:]
+	if utils.NormalizeClassList != nil {
+		mergedClasses = utils.NormalizeClassList(mergedClasses)
+	}
+	if utils.OptimizeClassList != nil {
+		mergedClasses = utils.OptimizeClassList(mergedClasses)
+	}
+	if utils.SortClassList != nil {
+		mergedClasses = utils.SortClassList(mergedClasses)
+	}
+
+	return strings.Join(mergedClasses, " ")
+}
+
+// classGroupIDOf resolves the class group of a parsed class name. A postfix
+// modifier such as the "/7" in "text-lg/7" is only treated as one when the
+// class without it resolves to a group; otherwise the full base class is
+// looked up and hasPostfixModifier is false. Returns "" for unknown classes.
+func classGroupIDOf(parsed ParsedClassName, utils *ConfigUtils) (classGroupID string, hasPostfixModifier bool) {
+	hasPostfixModifier = parsed.MaybePostfixModifierPosition != -1
+
+	if hasPostfixModifier {
+		classGroupID = utils.GetClassGroupID(parsed.BaseClassName[:parsed.MaybePostfixModifierPosition])
+	} else {
+		classGroupID = utils.GetClassGroupID(parsed.BaseClassName)
+	}
+
+	if classGroupID == "" && hasPostfixModifier {
+		classGroupID = utils.GetClassGroupID(parsed.BaseClassName)
+		hasPostfixModifier = false
+	}
+
+	return classGroupID, hasPostfixModifier
+}
+
+// modifierIDOf returns the identifier of the variant a parsed class applies
+// to: its sorted modifiers, followed by the important modifier if present.
+func modifierIDOf(parsed ParsedClassName, utils *ConfigUtils) string {
+	var variantModifier string
+	if len(parsed.Modifiers) > 0 {
+		// Even a single modifier goes through SortModifiers so variant
+		// aliases are resolved.
+		variantModifier = strings.Join(utils.SortModifiers(parsed.Modifiers), ":")
+	}
+
+	if parsed.HasImportantModifier {
+		return variantModifier + ImportantModifier
+	}
+	return variantModifier
 }
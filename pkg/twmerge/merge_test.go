@@ -180,3 +180,21 @@ func TestCreateTailwindMerge_CustomConfig(t *testing.T) {
 		t.Errorf("expected 'text-blue', got %q", result)
 	}
 }
+
+func TestSetDefaultConfig(t *testing.T) {
+	t.Cleanup(func() { SetDefaultConfig(GetDefaultConfig) })
+
+	if got, want := TwMerge("hover:(p-2 m-1)", "hover:p-4"), "hover:(p-2 m-1) hover:p-4"; got != want {
+		t.Errorf("TwMerge() = %q, want %q", got, want)
+	}
+
+	SetDefaultConfig(func() *Config {
+		config := GetDefaultConfig()
+		config.VariantGroups = true
+		return config
+	})
+
+	if got, want := TwMerge("hover:(p-2 m-1)", "hover:p-4"), "hover:m-1 hover:p-4"; got != want {
+		t.Errorf("TwMerge() after SetDefaultConfig = %q, want %q", got, want)
+	}
+}
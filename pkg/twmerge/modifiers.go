@@ -3,6 +3,7 @@ package twmerge
 import "sort"
 
 // CreateSortModifiers creates a function that sorts modifiers according to:
+// - Aliased modifiers are replaced by their canonical form first
 // - Regular modifiers are sorted alphabetically in segments
 // - Arbitrary variants (starting with '[') preserve their position
 // - Order-sensitive modifiers preserve their position
@@ -12,11 +13,17 @@ func CreateSortModifiers(config *Config) func([]string) []string {
 		modifierWeights[mod] = true
 	}
 
+	aliases := config.VariantAliases
+
 	return func(modifiers []string) []string {
 		result := make([]string, 0, len(modifiers))
 		var currentSegment []string
 
 		for _, modifier := range modifiers {
+			if canonical, ok := aliases[modifier]; ok {
+				modifier = canonical
+			}
+
 			isArbitrary := len(modifier) > 0 && modifier[0] == '['
 			isOrderSensitive := modifierWeights[modifier]
 
@@ -176,3 +176,70 @@ func TestMergeModifierSorting(t *testing.T) {
 		})
 	}
 }
+
+func TestSortModifiers_VariantAliases(t *testing.T) {
+	sortMods := CreateSortModifiers(&Config{
+		VariantAliases: map[string]string{"[.dark_&]": "dark", "min-md": "md"},
+	})
+
+	result := sortMods([]string{"min-md", "hover", "[.dark_&]"})
+	expected := []string{"dark", "hover", "md"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestMergeVariantAliases(t *testing.T) {
+	tests := []struct {
+		name    string
+		classes string
+		want    string
+	}{
+		{
+			name:    "min breakpoint alias conflicts with breakpoint",
+			classes: "md:p-2 min-md:p-4",
+			want:    "min-md:p-4",
+		},
+		{
+			name:    "container query alias",
+			classes: "@min-md:flex @md:grid",
+			want:    "@md:grid",
+		},
+		{
+			name:    "arbitrary variant alias",
+			classes: "first:mt-0 [&:first-child]:mt-2",
+			want:    "[&:first-child]:mt-2",
+		},
+		{
+			name:    "aliases combine with other modifiers",
+			classes: "hover:min-lg:bg-red-500 lg:hover:bg-blue-500",
+			want:    "lg:hover:bg-blue-500",
+		},
+		{
+			name:    "max breakpoints are not aliased",
+			classes: "max-md:p-2 md:p-4",
+			want:    "max-md:p-2 md:p-4",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TwMerge(tt.classes); got != tt.want {
+				t.Errorf("TwMerge(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeCustomVariantAliases(t *testing.T) {
+	merge := CreateTailwindMerge(func() *Config {
+		config := GetDefaultConfig()
+		config.VariantAliases["[.dark_&]"] = "dark"
+		return config
+	})
+
+	got := merge("hover:bg-red-500 dark:bg-blue-500 [.dark_&]:bg-green-500")
+	want := "hover:bg-red-500 [.dark_&]:bg-green-500"
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,165 @@
+package twmerge
+
+import "strings"
+
+// optimizedClass holds what the optimizer needs to know about a merged class.
+type optimizedClass struct {
+	utils              *ConfigUtils // utilities of the class prefix
+	className          string
+	classGroupID       string // "" for non-Tailwind classes
+	hasPostfixModifier bool
+	baseClassName      string
+	important          bool
+	variant            string // sorted modifiers without the important modifier
+	breakpoint         int    // index in Config.Breakpoints, -1 without one
+	responsiveVariant  string // variant without the breakpoint
+}
+
+// createOptimizeClassList creates the post-pass removing merged classes that
+// can never have an effect:
+//   - repeated non-Tailwind classes, keeping the last occurrence
+//   - non-important classes shadowed by an important class of the same or an
+//     overriding class group under the same variant
+//   - responsive classes identical to the class applying at the next smaller
+//     breakpoint, such as "sm:p-2" after "p-2"
+func createOptimizeClassList(config *Config, utils *ConfigUtils) func([]string) []string {
+	breakpoints := make(map[string]int, len(config.Breakpoints))
+	for i, breakpoint := range config.Breakpoints {
+		breakpoints[breakpoint] = i
+	}
+
+	analyze := func(className string) optimizedClass {
+		classUtils, prefixID := utils.utilsFor(className)
+		class := optimizedClass{utils: classUtils, className: className, breakpoint: -1}
+
+		parsed := classUtils.ParseClassName(className)
+		if parsed.IsExternal {
+			return class
+		}
+
+		class.classGroupID, class.hasPostfixModifier = classGroupIDOf(parsed, classUtils)
+		if class.classGroupID == "" {
+			return class
+		}
+
+		class.baseClassName = parsed.BaseClassName
+		class.important = parsed.HasImportantModifier
+
+		modifiers := classUtils.SortModifiers(parsed.Modifiers)
+		class.variant = prefixID + strings.Join(modifiers, ":")
+
+		var rest []string
+		for _, modifier := range modifiers {
+			if index, ok := breakpoints[modifier]; ok {
+				if class.breakpoint != -1 {
+					// Classes with several breakpoints are left alone.
+					class.breakpoint = -2
+				} else {
+					class.breakpoint = index
+				}
+				continue
+			}
+			rest = append(rest, modifier)
+		}
+		class.responsiveVariant = prefixID + strings.Join(rest, ":")
+
+		return class
+	}
+
+	overrides := func(a, b optimizedClass) bool {
+		if a.utils != b.utils {
+			return false
+		}
+		if a.classGroupID == b.classGroupID {
+			return true
+		}
+		for _, group := range a.utils.GetConflictingClassGroupIDs(a.classGroupID, a.hasPostfixModifier) {
+			if group == b.classGroupID {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(classNames []string) []string {
+		classes := make([]optimizedClass, len(classNames))
+		for i, className := range classNames {
+			classes[i] = analyze(className)
+		}
+
+		redundant := make([]bool, len(classes))
+		seenExternal := make(map[string]struct{})
+
+		for i := len(classes) - 1; i >= 0; i-- {
+			class := classes[i]
+
+			if class.classGroupID == "" {
+				if _, ok := seenExternal[class.className]; ok {
+					redundant[i] = true
+				}
+				seenExternal[class.className] = struct{}{}
+				continue
+			}
+
+			if !class.important && isShadowedByImportant(class, classes, overrides) {
+				redundant[i] = true
+				continue
+			}
+
+			if class.breakpoint >= 0 && isRedundantResponsive(class, classes, overrides) {
+				redundant[i] = true
+			}
+		}
+
+		result := classNames[:0:0]
+		for i, className := range classNames {
+			if !redundant[i] {
+				result = append(result, className)
+			}
+		}
+		return result
+	}
+}
+
+func isShadowedByImportant(class optimizedClass, classes []optimizedClass, overrides func(a, b optimizedClass) bool) bool {
+	for _, other := range classes {
+		if other.important && other.variant == class.variant && overrides(other, class) {
+			return true
+		}
+	}
+	return false
+}
+
+// isRedundantResponsive walks down the breakpoints below the class and
+// reports whether the first class it meets for the same group sets the same
+// value. A class of an overlapping group on the way means the value may
+// differ, so the class is kept.
+func isRedundantResponsive(class optimizedClass, classes []optimizedClass, overrides func(a, b optimizedClass) bool) bool {
+	for breakpoint := class.breakpoint - 1; breakpoint >= -1; breakpoint-- {
+		var match *optimizedClass
+		overlapping := false
+
+		for i := range classes {
+			other := &classes[i]
+			if other.classGroupID == "" || other.breakpoint != breakpoint ||
+				other.important != class.important || other.responsiveVariant != class.responsiveVariant {
+				continue
+			}
+
+			if other.classGroupID == class.classGroupID {
+				match = other
+			} else if overrides(*other, class) || overrides(class, *other) {
+				overlapping = true
+			}
+		}
+
+		if overlapping {
+			return false
+		}
+		if match != nil {
+			return match.baseClassName == class.baseClassName
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,101 @@
+package twmerge
+
+import "testing"
+
+func TestOptimize(t *testing.T) {
+	merge := CreateTailwindMerge(func() *Config {
+		config := GetDefaultConfig()
+		config.Optimize = true
+		return config
+	})
+
+	tests := []struct {
+		name    string
+		classes string
+		want    string
+	}{
+		{
+			name:    "duplicate non-tailwind classes",
+			classes: "card p-2 card shadow card",
+			want:    "p-2 shadow card",
+		},
+		{
+			name:    "responsive class identical to base",
+			classes: "p-2 sm:p-2",
+			want:    "p-2",
+		},
+		{
+			name:    "responsive class identical to smaller breakpoint",
+			classes: "p-2 sm:p-4 lg:p-4",
+			want:    "p-2 sm:p-4",
+		},
+		{
+			name:    "intermediate breakpoint overrides",
+			classes: "p-2 md:p-4 lg:p-2",
+			want:    "p-2 md:p-4 lg:p-2",
+		},
+		{
+			name:    "intermediate breakpoint with overlapping group",
+			classes: "p-2 sm:px-4 md:p-2",
+			want:    "p-2 sm:px-4 md:p-2",
+		},
+		{
+			name:    "overlapping group at the same level",
+			classes: "p-2 px-4 sm:p-2",
+			want:    "p-2 px-4 sm:p-2",
+		},
+		{
+			name:    "chain of identical values",
+			classes: "p-2 sm:p-2 md:p-2",
+			want:    "p-2",
+		},
+		{
+			name:    "other modifiers must match",
+			classes: "hover:p-2 sm:p-2 sm:hover:p-2",
+			want:    "hover:p-2 sm:p-2",
+		},
+		{
+			name:    "no smaller class",
+			classes: "md:p-2 lg:p-2",
+			want:    "md:p-2",
+		},
+		{
+			name:    "breakpoint aliases",
+			classes: "p-2 min-md:p-2",
+			want:    "p-2",
+		},
+		{
+			name:    "max breakpoints are left alone",
+			classes: "p-2 max-md:p-2",
+			want:    "p-2 max-md:p-2",
+		},
+		{
+			name:    "shadowed by important class of same group",
+			classes: "!font-bold font-thin",
+			want:    "!font-bold",
+		},
+		{
+			name:    "shadowed by important class of overriding group",
+			classes: "!p-4 px-2 hover:px-2",
+			want:    "!p-4 hover:px-2",
+		},
+		{
+			name:    "important class of overridden group does not shadow",
+			classes: "!px-4 p-2",
+			want:    "!px-4 p-2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := merge(tt.classes); got != tt.want {
+				t.Errorf("merge(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptimize_DisabledByDefault(t *testing.T) {
+	if got, want := TwMerge("card p-2 sm:p-2 card"), "card p-2 sm:p-2 card"; got != want {
+		t.Errorf("TwMerge() = %q, want %q", got, want)
+	}
+}
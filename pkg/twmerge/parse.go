@@ -1,27 +1,53 @@
 package twmerge
 
+import "strings"
+
 const (
 	ImportantModifier = "!"
 	modifierSeparator = ':'
 )
 
+// ImportantModifierStyle selects which important modifier syntax the parser
+// recognizes.
+type ImportantModifierStyle int
+
+const (
+	// ImportantModifierAny recognizes both "p-4!" and "!p-4".
+	ImportantModifierAny ImportantModifierStyle = iota
+	// ImportantModifierSuffix only recognizes the Tailwind v4 "p-4!".
+	ImportantModifierSuffix
+	// ImportantModifierPrefix only recognizes the Tailwind v3 "!p-4".
+	ImportantModifierPrefix
+)
+
+// separatorOf returns the modifier separator of config.
+func separatorOf(config *Config) string {
+	if config.Separator == "" {
+		return string(modifierSeparator)
+	}
+	return config.Separator
+}
+
 // CreateParseClassName creates a parser function for the given config.
 // The parser splits a class name into modifiers, base class, and metadata.
 func CreateParseClassName(config *Config) func(string) ParsedClassName {
+	separator := separatorOf(config)
+	importantStyle := config.ImportantModifierStyle
+
 	parseClassName := func(className string) ParsedClassName {
 		var modifiers []string
-		bracketDepth := 0
-		parenDepth := 0
+		var scanner arbitraryScanner
 		modifierStart := 0
 		postfixModifierPosition := -1
 
 		for i := 0; i < len(className); i++ {
 			ch := className[i]
 
-			if bracketDepth == 0 && parenDepth == 0 {
-				if ch == byte(modifierSeparator) {
+			if scanner.topLevel() {
+				if strings.HasPrefix(className[i:], separator) {
 					modifiers = append(modifiers, className[modifierStart:i])
-					modifierStart = i + 1
+					modifierStart = i + len(separator)
+					i += len(separator) - 1
 					continue
 				}
 
@@ -31,16 +57,7 @@ func CreateParseClassName(config *Config) func(string) ParsedClassName {
 				}
 			}
 
-			switch ch {
-			case '[':
-				bracketDepth++
-			case ']':
-				bracketDepth--
-			case '(':
-				parenDepth++
-			case ')':
-				parenDepth--
-			}
+			scanner.next(ch)
 		}
 
 		baseClassNameWithImportantModifier := className
@@ -51,11 +68,11 @@ func CreateParseClassName(config *Config) func(string) ParsedClassName {
 		baseClassName := baseClassNameWithImportantModifier
 		hasImportantModifier := false
 
-		if len(baseClassNameWithImportantModifier) > 0 &&
+		if importantStyle != ImportantModifierPrefix && len(baseClassNameWithImportantModifier) > 0 &&
 			baseClassNameWithImportantModifier[len(baseClassNameWithImportantModifier)-1] == '!' {
 			baseClassName = baseClassNameWithImportantModifier[:len(baseClassNameWithImportantModifier)-1]
 			hasImportantModifier = true
-		} else if len(baseClassNameWithImportantModifier) > 0 &&
+		} else if importantStyle != ImportantModifierSuffix && len(baseClassNameWithImportantModifier) > 0 &&
 			baseClassNameWithImportantModifier[0] == '!' {
 			// Legacy Tailwind v3 important modifier at start
 			baseClassName = baseClassNameWithImportantModifier[1:]
@@ -76,8 +93,34 @@ func CreateParseClassName(config *Config) func(string) ParsedClassName {
 		}
 	}
 
-	if config.Prefix != "" {
-		fullPrefix := config.Prefix + string(modifierSeparator)
+	if config.Prefix != "" && config.LegacyPrefix {
+		originalParseClassName := parseClassName
+		parseClassName = func(className string) ParsedClassName {
+			parsed := originalParseClassName(className)
+
+			sign := ""
+			baseClassName := parsed.BaseClassName
+			if strings.HasPrefix(baseClassName, "-") {
+				sign = "-"
+				baseClassName = baseClassName[1:]
+			}
+
+			if !strings.HasPrefix(baseClassName, config.Prefix) {
+				return ParsedClassName{
+					BaseClassName:                className,
+					MaybePostfixModifierPosition: -1,
+					IsExternal:                   true,
+				}
+			}
+
+			parsed.BaseClassName = sign + baseClassName[len(config.Prefix):]
+			if parsed.MaybePostfixModifierPosition != -1 {
+				parsed.MaybePostfixModifierPosition -= len(config.Prefix)
+			}
+			return parsed
+		}
+	} else if config.Prefix != "" {
+		fullPrefix := config.Prefix + separator
 		originalParseClassName := parseClassName
 		parseClassName = func(className string) ParsedClassName {
 			if len(className) >= len(fullPrefix) && className[:len(fullPrefix)] == fullPrefix {
@@ -93,5 +136,71 @@ func CreateParseClassName(config *Config) func(string) ParsedClassName {
 		}
 	}
 
+	if config.ExperimentalParseClassName != nil {
+		defaultParseClassName := parseClassName
+		parseClassName = func(className string) ParsedClassName {
+			return config.ExperimentalParseClassName(className, defaultParseClassName)
+		}
+	}
+
 	return parseClassName
 }
+
+// baseClassStart returns the index at which the base class of className
+// starts, after its last modifier separator outside of brackets.
+func baseClassStart(className string, separator string) int {
+	start := 0
+	var scanner arbitraryScanner
+	for i := 0; i < len(className); i++ {
+		if scanner.topLevel() && strings.HasPrefix(className[i:], separator) {
+			start = i + len(separator)
+			i += len(separator) - 1
+			continue
+		}
+		scanner.next(className[i])
+	}
+	return start
+}
+
+// createNormalizeImportantModifiers creates the post-pass moving the
+// important modifier of merged Tailwind classes to where the Tailwind version
+// of config expects it: the end of the class for v4, so "hover:!p-4"
+// becomes "hover:p-4!", and the start of the base class for v3 configs with
+// LegacyPrefix or ImportantModifierPrefix, so "hover:p-4!" becomes
+// "hover:!p-4".
+func createNormalizeImportantModifiers(config *Config, utils *ConfigUtils) func([]string) []string {
+	separator := separatorOf(config)
+	prefixForm := config.LegacyPrefix || config.ImportantModifierStyle == ImportantModifierPrefix
+
+	normalize := func(className string) string {
+		classUtils, _ := utils.utilsFor(className)
+		parsed := classUtils.ParseClassName(className)
+		if parsed.IsExternal || !parsed.HasImportantModifier {
+			return className
+		}
+		if classGroupID, _ := classGroupIDOf(parsed, classUtils); classGroupID == "" {
+			return className
+		}
+
+		start := baseClassStart(className, separator)
+		base := className[start:]
+		if prefixForm {
+			if !strings.HasSuffix(base, ImportantModifier) {
+				return className
+			}
+			return className[:start] + ImportantModifier + strings.TrimSuffix(base, ImportantModifier)
+		}
+		if !strings.HasPrefix(base, ImportantModifier) {
+			return className
+		}
+		return className[:start] + strings.TrimPrefix(base, ImportantModifier) + ImportantModifier
+	}
+
+	return func(classNames []string) []string {
+		normalized := make([]string, len(classNames))
+		for i, className := range classNames {
+			normalized[i] = normalize(className)
+		}
+		return normalized
+	}
+}
@@ -2,6 +2,7 @@ package twmerge
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -163,3 +164,57 @@ func TestParse_PostfixNotSet(t *testing.T) {
 		t.Errorf("expected postfix position -1, got %d", result.MaybePostfixModifierPosition)
 	}
 }
+
+func TestParse_ExperimentalParseClassName(t *testing.T) {
+	parse := CreateParseClassName(&Config{
+		Prefix: "tw",
+		ExperimentalParseClassName: func(className string, parseClassName func(string) ParsedClassName) ParsedClassName {
+			if strings.HasPrefix(className, "js-") {
+				return ParsedClassName{BaseClassName: className, MaybePostfixModifierPosition: -1, IsExternal: true}
+			}
+			return parseClassName(strings.TrimPrefix(className, "@"))
+		},
+	})
+
+	if result := parse("js-p-4"); !result.IsExternal {
+		t.Error("expected js-p-4 to be external")
+	}
+
+	result := parse("@tw:hover:p-4")
+	if result.IsExternal || result.BaseClassName != "p-4" || !reflect.DeepEqual(result.Modifiers, []string{"hover"}) {
+		t.Errorf("expected hover modifier and base class 'p-4', got %+v", result)
+	}
+
+	if result := parse("hover:p-4"); !result.IsExternal {
+		t.Error("expected unprefixed class to stay external with the default parser")
+	}
+}
+
+func TestMerge_ExperimentalParseClassName(t *testing.T) {
+	merge := CreateTailwindMerge(func() *Config {
+		config := GetDefaultConfig()
+		config.ExperimentalParseClassName = func(className string, parseClassName func(string) ParsedClassName) ParsedClassName {
+			if strings.HasPrefix(className, "ds-") {
+				return ParsedClassName{BaseClassName: className, MaybePostfixModifierPosition: -1, IsExternal: true}
+			}
+			return parseClassName(strings.TrimSuffix(className, "~"))
+		}
+		return config
+	})
+
+	tests := []struct {
+		classes string
+		want    string
+	}{
+		{"ds-p-2 p-4 ds-p-2", "ds-p-2 p-4 ds-p-2"},
+		{"p-2~ p-4", "p-4"},
+		{"p-2 hover:p-4~ hover:p-6", "p-2 hover:p-6"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.classes, func(t *testing.T) {
+			if got := merge(tt.classes); got != tt.want {
+				t.Errorf("merge(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package twmerge
+
+import (
+	"sort"
+	"strings"
+)
+
+// prefixUtils holds the utilities used for classes carrying a prefix of
+// Config.Prefixes.
+type prefixUtils struct {
+	prefix string // including the modifier separator, like "tw:"
+	utils  *ConfigUtils
+}
+
+// createPrefixUtils creates the utilities of each prefix in config.Prefixes,
+// longest prefix first. Prefixes mapped to nil share the class groups of
+// config.
+func createPrefixUtils(config *Config) []prefixUtils {
+	prefixes := make([]prefixUtils, 0, len(config.Prefixes))
+	for prefix, prefixConfig := range config.Prefixes {
+		c := *config
+		if prefixConfig != nil {
+			c = *prefixConfig
+		}
+		c.Prefix = prefix
+		c.Prefixes = nil
+		c.LegacyPrefix = false
+
+		prefixes = append(prefixes, prefixUtils{
+			prefix: prefix + separatorOf(&c),
+			utils:  CreateConfigUtils(&c),
+		})
+	}
+
+	sort.Slice(prefixes, func(i, j int) bool {
+		if len(prefixes[i].prefix) != len(prefixes[j].prefix) {
+			return len(prefixes[i].prefix) > len(prefixes[j].prefix)
+		}
+		return prefixes[i].prefix < prefixes[j].prefix
+	})
+
+	return prefixes
+}
+
+// withPrefixes makes utils dispatch classes to the utilities of their
+// prefix. Classes without one of the prefixes are external.
+func withPrefixes(config *Config, utils *ConfigUtils) {
+	utils.prefixes = createPrefixUtils(config)
+
+	utils.ParseClassName = func(className string) ParsedClassName {
+		classUtils, _ := utils.utilsFor(className)
+		if classUtils == utils {
+			return ParsedClassName{
+				BaseClassName:                className,
+				MaybePostfixModifierPosition: -1,
+				IsExternal:                   true,
+			}
+		}
+		return classUtils.ParseClassName(className)
+	}
+
+	utils.ExpandClassList = func(classList string) string {
+		if config.VariantGroups {
+			classList = expandVariantGroups(classList, separatorOf(config))
+		}
+
+		classNames := splitClassesRegex(classList)
+		for i, className := range classNames {
+			if classUtils, _ := utils.utilsFor(className); classUtils != utils {
+				classNames[i] = classUtils.ExpandClassList(className)
+			}
+		}
+		return strings.Join(classNames, " ")
+	}
+}
+
+// utilsFor returns the utilities to resolve className with, along with the
+// prefix to scope its conflicts by. Without Config.Prefixes, that is utils
+// itself and no prefix.
+func (u *ConfigUtils) utilsFor(className string) (*ConfigUtils, string) {
+	for _, p := range u.prefixes {
+		if strings.HasPrefix(className, p.prefix) {
+			return p.utils, p.prefix
+		}
+	}
+	return u, ""
+}
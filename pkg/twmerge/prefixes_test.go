@@ -0,0 +1,121 @@
+package twmerge
+
+import "testing"
+
+func newPrefixesConfig() *Config {
+	widget := GetDefaultConfig()
+	widget.ClassGroups = map[string][]ClassDefinition{
+		"widget-size":  {m("size", d("sm", "md", "lg")...)},
+		"widget-color": {m("tone", d("light", "dark")...)},
+	}
+	widget.ConflictingClassGroups = nil
+	widget.ClassGroupProperties = nil
+
+	config := GetDefaultConfig()
+	config.Prefixes = map[string]*Config{
+		"tw": nil,
+		"ui": widget,
+	}
+	return config
+}
+
+func TestPrefixes(t *testing.T) {
+	merge := CreateTailwindMerge(newPrefixesConfig)
+
+	tests := []struct {
+		name    string
+		classes string
+		want    string
+	}{
+		{
+			name:    "conflicts within a prefix",
+			classes: "tw:p-2 tw:px-4 tw:p-3",
+			want:    "tw:p-3",
+		},
+		{
+			name:    "no conflicts across prefixes",
+			classes: "tw:p-2 ui:size-sm tw:p-4 ui:size-lg",
+			want:    "tw:p-4 ui:size-lg",
+		},
+		{
+			name:    "prefixes with their own class groups",
+			classes: "ui:p-2 ui:p-4 ui:tone-light ui:tone-dark",
+			want:    "ui:p-2 ui:p-4 ui:tone-dark",
+		},
+		{
+			name:    "modifiers",
+			classes: "tw:hover:p-2 tw:hover:p-4 tw:focus:p-2",
+			want:    "tw:hover:p-4 tw:focus:p-2",
+		},
+		{
+			name:    "unprefixed classes are external",
+			classes: "p-2 p-4 tw:p-2",
+			want:    "p-2 p-4 tw:p-2",
+		},
+		{
+			name:    "unknown prefixes are external",
+			classes: "xx:p-2 xx:p-4",
+			want:    "xx:p-2 xx:p-4",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := merge(tt.classes); got != tt.want {
+				t.Errorf("merge(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrefixes_PerPrefixOptions(t *testing.T) {
+	merge := CreateTailwindMerge(func() *Config {
+		config := newPrefixesConfig()
+		config.VariantGroups = true
+		config.Optimize = true
+		config.Shortcuts = map[string]string{"btn": "px-4 py-2"}
+		return config
+	})
+
+	tests := []struct {
+		classes string
+		want    string
+	}{
+		{"tw:hover:(p-2 m-2) tw:hover:p-4", "tw:hover:m-2 tw:hover:p-4"},
+		{"tw:btn tw:px-8", "tw:py-2 tw:px-8"},
+		{"tw:p-2 tw:sm:p-2 ui:size-sm ui:sm:size-sm", "tw:p-2 ui:size-sm"},
+		{"tw:!p-2 ui:p-4", "tw:!p-2 ui:p-4"},
+	}
+	for _, tt := range tests {
+		if got := merge(tt.classes); got != tt.want {
+			t.Errorf("merge(%q) = %q, want %q", tt.classes, got, tt.want)
+		}
+	}
+}
+
+func TestPrefixes_Queries(t *testing.T) {
+	utils := CreateConfigUtils(newPrefixesConfig())
+
+	if !hasClassGroup("ui:tone-dark", "widget-color", nil, utils) {
+		t.Error("hasClassGroup(ui:tone-dark, widget-color) = false, want true")
+	}
+	if !hasClassGroup("tw:hover:text-red-500", "text-color", []string{"hover"}, utils) {
+		t.Error("hasClassGroup(tw:hover:text-red-500, text-color, hover) = false, want true")
+	}
+	if hasClassGroup("text-red-500", "text-color", nil, utils) {
+		t.Error("hasClassGroup(text-red-500, text-color) = true, want false for unprefixed class")
+	}
+
+	if parsed := utils.ParseClassName("ui:hover:tone-dark"); parsed.IsExternal || parsed.BaseClassName != "tone-dark" {
+		t.Errorf("ParseClassName(ui:hover:tone-dark) = %+v", parsed)
+	}
+	if parsed := utils.ParseClassName("tone-dark"); !parsed.IsExternal {
+		t.Errorf("ParseClassName(tone-dark) = %+v, want external", parsed)
+	}
+
+	if !diff("tw:p-2 ui:size-sm", "ui:size-sm tw:p-2", utils).Empty() {
+		t.Error("diff() of reordered prefixed classes is not empty")
+	}
+	if got := diff("tw:p-2", "ui:p-2", utils); len(got.Added) != 1 || len(got.Removed) != 1 {
+		t.Errorf("diff(tw:p-2, ui:p-2) = %+v, want one added and one removed class", got)
+	}
+}
@@ -0,0 +1,67 @@
+package twmerge
+
+// HasClassGroup reports whether classList contains a class of the given class
+// group applied under exactly the given modifiers, using the default
+// configuration. Modifier order does not matter and the important modifier is
+// ignored, so HasClassGroup("hover:!text-red-500", "text-color", "hover")
+// is true while HasClassGroup("hover:text-red-500", "text-color") is false.
+func HasClassGroup(classList string, groupID string, modifiers ...string) bool {
+	return hasClassGroup(classList, groupID, modifiers, getDefaultConfigUtils())
+}
+
+// ClassGroupsOf returns the class group IDs of all Tailwind classes in
+// classList, regardless of their modifiers, using the default configuration.
+// Each group is listed once, in order of first appearance.
+func ClassGroupsOf(classList string) []string {
+	return classGroupsOf(classList, getDefaultConfigUtils())
+}
+
+func hasClassGroup(classList string, groupID string, modifiers []string, utils *ConfigUtils) bool {
+	for _, className := range splitClassesRegex(utils.ExpandClassList(classList)) {
+		classUtils, _ := utils.utilsFor(className)
+		parsed := classUtils.ParseClassName(className)
+		if parsed.IsExternal {
+			continue
+		}
+
+		classGroupID, _ := classGroupIDOf(parsed, classUtils)
+		if classGroupID == groupID && variantKeyOf(parsed, classUtils) == variantKeyOf(ParsedClassName{Modifiers: modifiers}, classUtils) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func classGroupsOf(classList string, utils *ConfigUtils) []string {
+	var groups []string
+	seen := make(map[string]struct{})
+
+	for _, className := range splitClassesRegex(utils.ExpandClassList(classList)) {
+		classUtils, _ := utils.utilsFor(className)
+		parsed := classUtils.ParseClassName(className)
+		if parsed.IsExternal {
+			continue
+		}
+
+		classGroupID, _ := classGroupIDOf(parsed, classUtils)
+		if classGroupID == "" {
+			continue
+		}
+		if _, ok := seen[classGroupID]; ok {
+			continue
+		}
+
+		seen[classGroupID] = struct{}{}
+		groups = append(groups, classGroupID)
+	}
+
+	return groups
+}
+
+// variantKeyOf returns the modifier ID of a parsed class without the
+// important modifier.
+func variantKeyOf(parsed ParsedClassName, utils *ConfigUtils) string {
+	parsed.HasImportantModifier = false
+	return modifierIDOf(parsed, utils)
+}
@@ -0,0 +1,86 @@
+package twmerge
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHasClassGroup(t *testing.T) {
+	tests := []struct {
+		name      string
+		classList string
+		groupID   string
+		modifiers []string
+		want      bool
+	}{
+		{
+			name:      "unmodified text color",
+			classList: "p-4 text-red-500",
+			groupID:   "text-color",
+			want:      true,
+		},
+		{
+			name:      "font size is not a text color",
+			classList: "p-4 text-lg",
+			groupID:   "text-color",
+			want:      false,
+		},
+		{
+			name:      "modified class does not count as unmodified",
+			classList: "hover:text-red-500",
+			groupID:   "text-color",
+			want:      false,
+		},
+		{
+			name:      "matching modifier",
+			classList: "hover:text-red-500",
+			groupID:   "text-color",
+			modifiers: []string{"hover"},
+			want:      true,
+		},
+		{
+			name:      "modifier order is irrelevant",
+			classList: "dark:hover:bg-black",
+			groupID:   "bg-color",
+			modifiers: []string{"hover", "dark"},
+			want:      true,
+		},
+		{
+			name:      "important modifier is ignored",
+			classList: "!text-red-500",
+			groupID:   "text-color",
+			want:      true,
+		},
+		{
+			name:      "postfix modifier",
+			classList: "text-lg/7",
+			groupID:   "font-size",
+			want:      true,
+		},
+		{
+			name:      "non-tailwind class",
+			classList: "my-class",
+			groupID:   "text-color",
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasClassGroup(tt.classList, tt.groupID, tt.modifiers...); got != tt.want {
+				t.Errorf("HasClassGroup(%q, %q, %v) = %v, want %v", tt.classList, tt.groupID, tt.modifiers, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassGroupsOf(t *testing.T) {
+	got := ClassGroupsOf("p-4 hover:p-2 text-red-500 custom text-lg/7 [mask-type:alpha]")
+	want := []string{"p", "text-color", "font-size", "arbitrary..mask-type"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ClassGroupsOf() = %v, want %v", got, want)
+	}
+
+	if got := ClassGroupsOf("custom another"); got != nil {
+		t.Errorf("expected no groups for non-tailwind classes, got %v", got)
+	}
+}
@@ -0,0 +1,63 @@
+package twmerge
+
+import "testing"
+
+func TestSeparator(t *testing.T) {
+	merge := CreateTailwindMerge(func() *Config {
+		config := GetDefaultConfig()
+		config.Separator = "_"
+		config.VariantGroups = true
+		config.Shortcuts = map[string]string{"btn": "px-4 py-2"}
+		return config
+	})
+
+	tests := []struct {
+		classes string
+		want    string
+	}{
+		{"hover_p-2 hover_p-4", "hover_p-4"},
+		{"hover_p-2 focus_p-4", "hover_p-2 focus_p-4"},
+		{"md_hover_p-2 hover_md_p-4", "hover_md_p-4"},
+		{"hover_p-2 hover:p-4", "hover_p-2 hover:p-4"},
+		{"bg-[url(a_b.png)] bg-[url(c_d.png)]", "bg-[url(c_d.png)]"},
+		{"hover_!p-2 hover_p-4!", "hover_p-4!"},
+		{"hover_(p-2 m-2) hover_p-4", "hover_m-2 hover_p-4"},
+		{"md_btn md_px-8", "md_py-2 md_px-8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.classes, func(t *testing.T) {
+			if got := merge(tt.classes); got != tt.want {
+				t.Errorf("merge(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSeparatorWithPrefix(t *testing.T) {
+	tests := []struct {
+		name    string
+		legacy  bool
+		classes string
+		want    string
+	}{
+		{"variant prefix", false, "tw_hover_p-2 tw_hover_p-4 hover_p-2", "tw_hover_p-4 hover_p-2"},
+		{"glued prefix", true, "hover_tw-p-2 hover_tw-p-4", "hover_tw-p-4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merge := CreateTailwindMerge(func() *Config {
+				config := GetDefaultConfig()
+				config.Separator = "_"
+				config.LegacyPrefix = tt.legacy
+				config.Prefix = "tw"
+				if tt.legacy {
+					config.Prefix = "tw-"
+				}
+				return config
+			})
+			if got := merge(tt.classes); got != tt.want {
+				t.Errorf("merge(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,256 @@
+package twmerge
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	cssCommentRegex       = regexp.MustCompile(`(?s)/\*.*?\*/`)
+	shortcutSelectorRegex = regexp.MustCompile(`^\.(-?[A-Za-z_][\w-]*)$`)
+	utilityPreludeRegex   = regexp.MustCompile(`^@utility\s+(-?[A-Za-z_][\w-]*)$`)
+)
+
+// createExpandShortcuts creates the pre-pass replacing shortcuts by their
+// utilities. Modifiers and the important modifier on a shortcut are applied
+// to each of its utilities, so "hover:btn!" expands "btn" into hover variants
+// of its utilities marked important. Shortcuts that are part of a cycle are
+// left unexpanded.
+func createExpandShortcuts(config *Config, parseClassName func(string) ParsedClassName) func(string) string {
+	shortcuts := config.Shortcuts
+	separator := separatorOf(config)
+	cyclic := cyclicShortcuts(shortcuts, separator)
+	// Utilities of shortcuts are unprefixed, so they are parsed without the
+	// prefix of config.
+	parseUtility := CreateParseClassName(&Config{Separator: config.Separator})
+
+	var expand func(className string) []string
+	expand = func(className string) []string {
+		parsed := parseClassName(className)
+		if parsed.IsExternal || cyclic[parsed.BaseClassName] {
+			return []string{className}
+		}
+
+		shortcut, ok := shortcuts[parsed.BaseClassName]
+		if !ok {
+			return []string{className}
+		}
+
+		var expanded []string
+		for _, utility := range splitClassesRegex(shortcut) {
+			var prefix string
+			if config.Prefix != "" && !config.LegacyPrefix {
+				prefix = config.Prefix + separator
+			}
+			// Modifiers the utility already has are not repeated, so
+			// "hover:btn" expands "hover:-mt-1" to "hover:-mt-1".
+			utilityModifiers := make(map[string]bool)
+			for _, modifier := range parseUtility(utility).Modifiers {
+				utilityModifiers[modifier] = true
+			}
+			for _, modifier := range parsed.Modifiers {
+				if !utilityModifiers[modifier] {
+					prefix += modifier + separator
+				}
+			}
+
+			if config.LegacyPrefix {
+				utility = gluePrefix(utility, config.Prefix, separator, parsed.HasImportantModifier)
+			} else if parsed.HasImportantModifier && !strings.HasSuffix(utility, ImportantModifier) && !strings.HasPrefix(utility, ImportantModifier) {
+				if config.ImportantModifierStyle == ImportantModifierPrefix {
+					start := baseClassStart(utility, separator)
+					utility = utility[:start] + ImportantModifier + utility[start:]
+				} else {
+					utility += ImportantModifier
+				}
+			}
+			expanded = append(expanded, expand(prefix+utility)...)
+		}
+
+		return expanded
+	}
+
+	return func(classList string) string {
+		var expanded []string
+		for _, className := range splitClassesRegex(classList) {
+			expanded = append(expanded, expand(className)...)
+		}
+		return strings.Join(expanded, " ")
+	}
+}
+
+// cyclicShortcuts returns the names of the shortcuts that expand, directly
+// or through other shortcuts, to themselves.
+func cyclicShortcuts(shortcuts map[string]string, separator string) map[string]bool {
+	references := make(map[string][]string, len(shortcuts))
+	for name, shortcut := range shortcuts {
+		for _, utility := range splitClassesRegex(shortcut) {
+			base := utility[baseClassStart(utility, separator):]
+			base = strings.TrimSuffix(strings.TrimPrefix(base, ImportantModifier), ImportantModifier)
+			if _, ok := shortcuts[base]; ok {
+				references[name] = append(references[name], base)
+			}
+		}
+	}
+
+	cyclic := make(map[string]bool)
+	for name := range shortcuts {
+		visited := make(map[string]bool)
+		stack := append([]string(nil), references[name]...)
+		for len(stack) > 0 {
+			next := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if next == name {
+				cyclic[name] = true
+				break
+			}
+			if !visited[next] {
+				visited[next] = true
+				stack = append(stack, references[next]...)
+			}
+		}
+	}
+
+	return cyclic
+}
+
+// gluePrefix adds a Tailwind v3 style prefix to the base class of an
+// unprefixed utility, after its modifiers and the leading important modifier
+// and minus sign: "hover:-m-2" becomes "hover:-tw-m-2". important adds the
+// important modifier if the utility does not have it yet.
+func gluePrefix(utility string, prefix string, separator string, important bool) string {
+	baseStart := baseClassStart(utility, separator)
+	modifiers, base := utility[:baseStart], utility[baseStart:]
+	if strings.HasPrefix(base, ImportantModifier) {
+		important = true
+		base = base[1:]
+	} else if strings.HasSuffix(base, ImportantModifier) {
+		important = true
+		base = base[:len(base)-1]
+	}
+
+	sign := ""
+	if strings.HasPrefix(base, "-") {
+		sign = "-"
+		base = base[1:]
+	}
+
+	if important {
+		return modifiers + ImportantModifier + sign + prefix + base
+	}
+	return modifiers + sign + prefix + base
+}
+
+// ShortcutsFromCSS extracts shortcuts from CSS component definitions made of
+// @apply rules only, either Tailwind v3 style
+//
+//	@layer components { .btn { @apply inline-flex px-4 py-2; } }
+//
+// or Tailwind v4 style
+//
+//	@utility btn { @apply inline-flex px-4 py-2; }
+//
+// Rules with other declarations or complex selectors are skipped, since
+// replacing them by their utilities would drop styles.
+func ShortcutsFromCSS(css string) (map[string]string, error) {
+	blocks, err := parseCSSBlocks(cssCommentRegex.ReplaceAllString(css, ""))
+	if err != nil {
+		return nil, err
+	}
+
+	shortcuts := make(map[string]string)
+	for _, block := range blocks {
+		if block.prelude == "@layer components" {
+			rules, err := parseCSSBlocks(block.body)
+			if err != nil {
+				return nil, err
+			}
+			for _, rule := range rules {
+				if match := shortcutSelectorRegex.FindStringSubmatch(rule.prelude); match != nil {
+					addShortcut(shortcuts, match[1], rule.body)
+				}
+			}
+			continue
+		}
+
+		if match := utilityPreludeRegex.FindStringSubmatch(block.prelude); match != nil {
+			addShortcut(shortcuts, match[1], block.body)
+		}
+	}
+
+	if cyclic := cyclicShortcuts(shortcuts, string(modifierSeparator)); len(cyclic) > 0 {
+		names := make([]string, 0, len(cyclic))
+		for name := range cyclic {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("cyclic shortcuts: %s", strings.Join(names, ", "))
+	}
+
+	return shortcuts, nil
+}
+
+func addShortcut(shortcuts map[string]string, name string, body string) {
+	var utilities []string
+	for _, declaration := range strings.Split(body, ";") {
+		declaration = strings.TrimSpace(declaration)
+		if declaration == "" {
+			continue
+		}
+		if !strings.HasPrefix(declaration, "@apply ") {
+			return
+		}
+		utilities = append(utilities, strings.Fields(strings.TrimPrefix(declaration, "@apply "))...)
+	}
+
+	if len(utilities) > 0 {
+		shortcuts[name] = strings.Join(utilities, " ")
+	}
+}
+
+type cssBlock struct {
+	prelude string
+	body    string
+}
+
+// parseCSSBlocks splits CSS into its top-level blocks. Statements without a
+// block, such as @import, are ignored.
+func parseCSSBlocks(css string) ([]cssBlock, error) {
+	var blocks []cssBlock
+	depth := 0
+	start := 0
+	bodyStart := 0
+	var prelude string
+
+	for i := 0; i < len(css); i++ {
+		switch css[i] {
+		case '{':
+			if depth == 0 {
+				prelude = strings.Join(strings.Fields(css[start:i]), " ")
+				bodyStart = i + 1
+			}
+			depth++
+		case '}':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unexpected '}' at offset %d", i)
+			}
+			if depth == 0 {
+				blocks = append(blocks, cssBlock{prelude: prelude, body: css[bodyStart:i]})
+				start = i + 1
+			}
+		case ';':
+			if depth == 0 {
+				start = i + 1
+			}
+		}
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("unclosed block %q", prelude)
+	}
+
+	return blocks, nil
+}
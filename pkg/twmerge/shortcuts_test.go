@@ -0,0 +1,153 @@
+package twmerge
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newShortcutsMerge(prefix string) func(classes ...string) string {
+	return CreateTailwindMerge(func() *Config {
+		config := GetDefaultConfig()
+		config.Prefix = prefix
+		config.Shortcuts = map[string]string{
+			"btn":         "inline-flex items-center px-4 py-2 rounded-md",
+			"btn-primary": "btn bg-blue-600 text-white",
+			"loop-a":      "loop-b p-1",
+			"loop-b":      "loop-a m-1",
+			"uses-loop":   "loop-b p-2",
+			"link":        "text-blue-600 hover:underline",
+		}
+		return config
+	})
+}
+
+func TestShortcuts(t *testing.T) {
+	merge := newShortcutsMerge("")
+
+	tests := []struct {
+		name    string
+		classes []string
+		want    string
+	}{
+		{
+			name:    "shortcut utilities can be overridden",
+			classes: []string{"btn", "px-8"},
+			want:    "inline-flex items-center py-2 rounded-md px-8",
+		},
+		{
+			name:    "later shortcut overrides earlier utilities",
+			classes: []string{"px-8 rounded-none", "btn"},
+			want:    "inline-flex items-center px-4 py-2 rounded-md",
+		},
+		{
+			name:    "nested shortcuts",
+			classes: []string{"btn-primary", "bg-red-600"},
+			want:    "inline-flex items-center px-4 py-2 rounded-md text-white bg-red-600",
+		},
+		{
+			name:    "modifiers apply to every utility",
+			classes: []string{"hover:btn", "hover:py-3"},
+			want:    "hover:inline-flex hover:items-center hover:px-4 hover:rounded-md hover:py-3",
+		},
+		{
+			name:    "modifiers of utilities are not repeated",
+			classes: []string{"hover:link"},
+			want:    "hover:text-blue-600 hover:underline",
+		},
+		{
+			name:    "important modifier applies to every utility",
+			classes: []string{"btn!"},
+			want:    "inline-flex! items-center! px-4! py-2! rounded-md!",
+		},
+		{
+			name:    "unknown classes are kept",
+			classes: []string{"btn-secondary", "px-2"},
+			want:    "btn-secondary px-2",
+		},
+		{
+			name:    "cyclic shortcuts are left unexpanded",
+			classes: []string{"loop-a hover:loop-b"},
+			want:    "loop-a hover:loop-b",
+		},
+		{
+			name:    "shortcuts using a cyclic shortcut keep it unexpanded",
+			classes: []string{"uses-loop"},
+			want:    "loop-b p-2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := merge(tt.classes...); got != tt.want {
+				t.Errorf("merge(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShortcuts_Prefix(t *testing.T) {
+	merge := newShortcutsMerge("tw")
+
+	got := merge("tw:md:btn", "tw:md:px-8 btn")
+	want := "tw:md:inline-flex tw:md:items-center tw:md:py-2 tw:md:rounded-md tw:md:px-8 btn"
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestShortcutsFromCSS(t *testing.T) {
+	css := `
+@import "tailwindcss";
+
+@layer components {
+  /* Buttons */
+  .btn {
+    @apply inline-flex items-center;
+    @apply px-4 py-2 rounded-md;
+  }
+  .card { @apply p-6 shadow-sm; }
+  .link { color: blue; @apply underline; }
+  .nav a { @apply px-2; }
+}
+
+@utility chip {
+  @apply rounded-full px-2 text-xs;
+}
+
+.plain { @apply p-1; }
+`
+
+	got, err := ShortcutsFromCSS(css)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]string{
+		"btn":  "inline-flex items-center px-4 py-2 rounded-md",
+		"card": "p-6 shadow-sm",
+		"chip": "rounded-full px-2 text-xs",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ShortcutsFromCSS() = %v, want %v", got, want)
+	}
+}
+
+func TestShortcutsFromCSS_Unbalanced(t *testing.T) {
+	if _, err := ShortcutsFromCSS("@layer components { .btn { @apply p-4; }"); err == nil {
+		t.Error("expected error for unclosed block")
+	}
+	if _, err := ShortcutsFromCSS(".btn { @apply p-4; } }"); err == nil {
+		t.Error("expected error for unexpected closing brace")
+	}
+}
+
+func TestShortcutsFromCSS_Cycle(t *testing.T) {
+	css := `
+@utility btn { @apply px-4 btn-base; }
+@utility btn-base { @apply py-2 hover:btn; }
+@utility card { @apply p-6; }
+`
+	_, err := ShortcutsFromCSS(css)
+	if err == nil || err.Error() != "cyclic shortcuts: btn, btn-base" {
+		t.Errorf("ShortcutsFromCSS() error = %v, want cyclic shortcuts: btn, btn-base", err)
+	}
+}
@@ -0,0 +1,120 @@
+package twmerge
+
+import (
+	"sort"
+	"strings"
+)
+
+// sortedClass holds the sort key of a merged class.
+type sortedClass struct {
+	className    string
+	external     bool
+	variantRanks []int // descending
+	groupRank    int
+}
+
+// createSortClassList creates the post-pass sorting merged classes into a
+// stable order. Non-Tailwind classes come first in their
+// original order. Tailwind classes are sorted by their variants, classes
+// without variants first, and then by class group. Variants and groups
+// missing from the config are sorted after the known ones.
+func createSortClassList(config *Config, utils *ConfigUtils) func([]string) []string {
+	groupRanks := make(map[string]int, len(config.ClassGroupOrder))
+	for i, group := range config.ClassGroupOrder {
+		groupRanks[group] = i
+	}
+
+	variantRanks := make(map[string]int, len(config.VariantOrder))
+	var variantPrefixes []string
+	for i, variant := range config.VariantOrder {
+		variantRanks[variant] = i
+		if prefix := strings.TrimSuffix(variant, "*"); prefix != variant && prefix != "" {
+			variantPrefixes = append(variantPrefixes, prefix)
+		}
+	}
+	// Longest prefixes first so "@max-" wins over "@".
+	sort.SliceStable(variantPrefixes, func(i, j int) bool {
+		return len(variantPrefixes[i]) > len(variantPrefixes[j])
+	})
+
+	variantRank := func(modifier string) int {
+		if alias, ok := config.VariantAliases[modifier]; ok {
+			modifier = alias
+		}
+		if rank, ok := variantRanks[modifier]; ok {
+			return rank
+		}
+		for _, prefix := range variantPrefixes {
+			if strings.HasPrefix(modifier, prefix) {
+				return variantRanks[prefix+"*"]
+			}
+		}
+		return len(config.VariantOrder)
+	}
+
+	analyze := func(className string) sortedClass {
+		class := sortedClass{className: className}
+
+		classUtils, _ := utils.utilsFor(className)
+		parsed := classUtils.ParseClassName(className)
+		classGroupID := ""
+		if !parsed.IsExternal {
+			classGroupID, _ = classGroupIDOf(parsed, classUtils)
+		}
+		if classGroupID == "" {
+			class.external = true
+			return class
+		}
+
+		class.groupRank = len(config.ClassGroupOrder)
+		if rank, ok := groupRanks[classGroupID]; ok {
+			class.groupRank = rank
+		}
+
+		for _, modifier := range parsed.Modifiers {
+			class.variantRanks = append(class.variantRanks, variantRank(modifier))
+		}
+		sort.Sort(sort.Reverse(sort.IntSlice(class.variantRanks)))
+
+		return class
+	}
+
+	return func(classNames []string) []string {
+		classes := make([]sortedClass, len(classNames))
+		for i, className := range classNames {
+			classes[i] = analyze(className)
+		}
+
+		sort.SliceStable(classes, func(i, j int) bool {
+			a, b := classes[i], classes[j]
+			if a.external || b.external {
+				return a.external && !b.external
+			}
+			if c := compareVariantRanks(a.variantRanks, b.variantRanks); c != 0 {
+				return c < 0
+			}
+			return a.groupRank < b.groupRank
+		})
+
+		sorted := make([]string, len(classes))
+		for i, class := range classes {
+			sorted[i] = class.className
+		}
+		return sorted
+	}
+}
+
+// compareVariantRanks compares descending variant ranks like Tailwind CSS
+// compares its variant bitmasks: the class with the later variant sorts
+// last, and a class with fewer variants sorts first when the rest is equal.
+func compareVariantRanks(a, b []int) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return len(a) - len(b)
+}
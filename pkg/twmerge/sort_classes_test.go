@@ -0,0 +1,110 @@
+package twmerge
+
+import "testing"
+
+func TestSortClasses(t *testing.T) {
+	merge := CreateTailwindMerge(func() *Config {
+		config := GetDefaultConfig()
+		config.SortClasses = true
+		return config
+	})
+
+	tests := []struct {
+		name    string
+		classes string
+		want    string
+	}{
+		{
+			name:    "class group order",
+			classes: "text-white p-4 flex bg-red-500 w-full",
+			want:    "flex p-4 w-full text-white bg-red-500",
+		},
+		{
+			name:    "layout before spacing",
+			classes: "mt-2 relative grid gap-4",
+			want:    "grid relative gap-4 mt-2",
+		},
+		{
+			name:    "non-tailwind classes first",
+			classes: "p-4 card flex js-toggle",
+			want:    "card js-toggle flex p-4",
+		},
+		{
+			name:    "variants after base classes",
+			classes: "md:p-4 hover:bg-red-500 p-2 bg-white",
+			want:    "p-2 bg-white hover:bg-red-500 md:p-4",
+		},
+		{
+			name:    "variant order",
+			classes: "dark:text-white md:flex focus:ring-2 hover:underline",
+			want:    "hover:underline focus:ring-2 md:flex dark:text-white",
+		},
+		{
+			name:    "breakpoints from smallest to largest",
+			classes: "xl:p-8 sm:p-2 lg:p-6 md:p-4",
+			want:    "sm:p-2 md:p-4 lg:p-6 xl:p-8",
+		},
+		{
+			name:    "stacked variants after single variant",
+			classes: "md:hover:p-4 md:p-2",
+			want:    "md:p-2 md:hover:p-4",
+		},
+		{
+			name:    "functional variants",
+			classes: "md:flex data-[open]:block group-hover:underline",
+			want:    "group-hover:underline data-[open]:block md:flex",
+		},
+		{
+			name:    "variant aliases",
+			classes: "min-md:p-4 sm:p-2",
+			want:    "sm:p-2 min-md:p-4",
+		},
+		{
+			name:    "arbitrary variants last",
+			classes: "[&>*]:p-4 dark:p-2",
+			want:    "dark:p-2 [&>*]:p-4",
+		},
+		{
+			name:    "unknown groups last in original order",
+			classes: "[--b:1] [--a:2] p-4",
+			want:    "p-4 [--b:1] [--a:2]",
+		},
+		{
+			name:    "conflicts are still resolved",
+			classes: "p-2 flex p-4 block",
+			want:    "block p-4",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := merge(tt.classes); got != tt.want {
+				t.Errorf("merge(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortClasses_DisabledByDefault(t *testing.T) {
+	if got, want := TwMerge("p-4 card flex"), "p-4 card flex"; got != want {
+		t.Errorf("TwMerge() = %q, want %q", got, want)
+	}
+}
+
+func TestClassGroupOrder_CoversClassGroups(t *testing.T) {
+	config := GetDefaultConfig()
+
+	count := make(map[string]int, len(config.ClassGroupOrder))
+	for _, classGroupID := range config.ClassGroupOrder {
+		count[classGroupID]++
+	}
+	for classGroupID := range config.ClassGroups {
+		if count[classGroupID] != 1 {
+			t.Errorf("ClassGroupOrder contains %q %d times, want once", classGroupID, count[classGroupID])
+		}
+	}
+	for classGroupID := range count {
+		if _, ok := config.ClassGroups[classGroupID]; !ok {
+			t.Errorf("ClassGroupOrder contains unknown class group %q", classGroupID)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package twmerge
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ClassNameError describes a malformed class name found in strict mode.
+type ClassNameError struct {
+	Token    string // the malformed class name
+	Position int    // byte offset of the problem in Token
+	Reason   string
+}
+
+func (e *ClassNameError) Error() string {
+	return fmt.Sprintf("invalid class %q at position %d: %s", e.Token, e.Position, e.Reason)
+}
+
+// ValidateClassList checks every class in classList with the strict parser
+// of the default configuration and returns the malformed ones, in order.
+// Such classes are still merged as non-Tailwind classes by TwMerge.
+func ValidateClassList(classList string) []*ClassNameError {
+	return validateClassList(classList, getDefaultConfigUtils())
+}
+
+func validateClassList(classList string, utils *ConfigUtils) []*ClassNameError {
+	var errs []*ClassNameError
+	for _, className := range splitClassesRegex(utils.ExpandClassList(classList)) {
+		classUtils, _ := utils.utilsFor(className)
+		if err := classUtils.validateClassName(className); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// CreateStrictParseClassName creates a parser like CreateParseClassName that
+// rejects malformed class names, such as unbalanced brackets in "bg-[#fff",
+// empty modifiers in "hover::p-2" or a misplaced important modifier, with a
+// *ClassNameError.
+func CreateStrictParseClassName(config *Config) func(string) (ParsedClassName, error) {
+	parseClassName := CreateParseClassName(config)
+	validateClassName := createValidateClassName(config)
+
+	return func(className string) (ParsedClassName, error) {
+		if err := validateClassName(className); err != nil {
+			return ParsedClassName{}, err
+		}
+		return parseClassName(className), nil
+	}
+}
+
+// createValidateClassName creates the function checking the syntax of a
+// class name, independently of whether it is a Tailwind class.
+func createValidateClassName(config *Config) func(string) *ClassNameError {
+	separator := separatorOf(config)
+
+	return func(className string) *ClassNameError {
+		fail := func(position int, reason string) *ClassNameError {
+			return &ClassNameError{Token: className, Position: position, Reason: reason}
+		}
+
+		var scanner arbitraryScanner
+		modifierStart := 0
+		importantStart := -1 // important modifier in the current modifier
+
+		for i := 0; i < len(className); i++ {
+			ch := className[i]
+			if !scanner.inString() && (ch == ']' || ch == ')') {
+				position := scanner.unclosed()
+				if position == -1 {
+					return fail(i, fmt.Sprintf("unexpected '%c'", ch))
+				}
+				if opening := className[position]; opening == '[' && ch != ']' || opening == '(' && ch != ')' {
+					return fail(i, fmt.Sprintf("'%c' closes '%c' at position %d", ch, opening, position))
+				}
+			}
+
+			if !scanner.topLevel() || ch == '[' || ch == '(' || ch == ']' || ch == ')' {
+				scanner.next(ch)
+				continue
+			}
+			if !strings.HasPrefix(className[i:], separator) {
+				if importantStart == -1 && strings.HasPrefix(className[i:], ImportantModifier) {
+					importantStart = i
+				}
+				scanner.next(ch)
+				continue
+			}
+			if i == modifierStart {
+				return fail(i, "empty modifier")
+			}
+			if importantStart != -1 {
+				return fail(importantStart, "important modifier in a modifier")
+			}
+			for _, b := range []byte(separator) {
+				scanner.next(b)
+			}
+			modifierStart = i + len(separator)
+			i += len(separator) - 1
+		}
+
+		if scanner.escape {
+			return fail(len(className)-1, "unfinished escape")
+		}
+		if scanner.quote != 0 {
+			return fail(scanner.unclosed(), "unclosed quote")
+		}
+		if position := scanner.unclosed(); position != -1 {
+			return fail(position, fmt.Sprintf("unclosed '%c'", className[position]))
+		}
+
+		baseStart := modifierStart
+		important := false
+		if strings.HasPrefix(className[baseStart:], ImportantModifier) {
+			baseStart += len(ImportantModifier)
+			important = true
+		}
+		base := className[baseStart:]
+		if important && strings.HasSuffix(base, ImportantModifier) {
+			return fail(len(className)-len(ImportantModifier), "repeated important modifier")
+		}
+		if important && config.ImportantModifierStyle == ImportantModifierSuffix {
+			return fail(modifierStart, "important modifier must be a suffix")
+		}
+		if strings.HasSuffix(base, ImportantModifier) && config.ImportantModifierStyle == ImportantModifierPrefix {
+			return fail(len(className)-len(ImportantModifier), "important modifier must be a prefix")
+		}
+		base = strings.TrimSuffix(base, ImportantModifier)
+		if base == "" {
+			return fail(len(className), "missing utility")
+		}
+
+		var baseScanner arbitraryScanner
+		for i := 0; i < len(base); i++ {
+			if baseScanner.topLevel() && strings.HasPrefix(base[i:], ImportantModifier) {
+				return fail(baseStart+i, "misplaced important modifier")
+			}
+			baseScanner.next(base[i])
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,73 @@
+package twmerge
+
+import "sort"
+
+// defaultColorShades are the shades of every Tailwind CSS v4 palette color.
+var defaultColorShades = []string{"50", "100", "200", "300", "400", "500", "600", "700", "800", "900", "950"}
+
+// DefaultColors returns the Tailwind CSS v4 color palette, mapping each color
+// to its shades. Colors without shades, like "black", map to nil.
+func DefaultColors() map[string][]string {
+	colors := map[string][]string{
+		"inherit":     nil,
+		"current":     nil,
+		"transparent": nil,
+		"black":       nil,
+		"white":       nil,
+	}
+
+	for _, color := range []string{
+		"red", "orange", "amber", "yellow", "lime", "green", "emerald", "teal", "cyan", "sky",
+		"blue", "indigo", "violet", "purple", "fuchsia", "pink", "rose",
+		"slate", "gray", "zinc", "neutral", "stone", "mauve", "olive", "mist", "taupe",
+	} {
+		colors[color] = append([]string(nil), defaultColorShades...)
+	}
+
+	return colors
+}
+
+// ColorTheme turns colors mapped to their shades into theme definitions for
+// Theme["color"]. Colors without shades match on their own, and an empty
+// shade makes a color with shades match on its own too.
+func ColorTheme(colors map[string][]string) []ClassDefinition {
+	names := make([]string, 0, len(colors))
+	for name := range colors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	definitions := make([]ClassDefinition, 0, len(names))
+	for _, name := range names {
+		shades := colors[name]
+		if len(shades) == 0 {
+			definitions = append(definitions, name)
+			continue
+		}
+
+		shadeDefinitions := make([]ClassDefinition, len(shades))
+		for i, shade := range shades {
+			shadeDefinitions[i] = shade
+		}
+		definitions = append(definitions, map[string][]ClassDefinition{name: shadeDefinitions})
+	}
+
+	return definitions
+}
+
+// GetStrictConfig returns the default configuration with Theme["color"]
+// limited to the Tailwind CSS v4 palette and the given extra colors, instead
+// of accepting any value. Color groups then only match real colors, so
+// "text-huge" no longer conflicts with "text-red-500" and is left as a
+// non-Tailwind class unless another group matches it. Extra shades of a
+// palette color are added to its existing shades.
+func GetStrictConfig(extraColors map[string][]string) *Config {
+	colors := DefaultColors()
+	for name, shades := range extraColors {
+		colors[name] = append(colors[name], shades...)
+	}
+
+	config := GetDefaultConfig()
+	config.Theme["color"] = ColorTheme(colors)
+	return config
+}
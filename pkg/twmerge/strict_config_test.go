@@ -0,0 +1,100 @@
+package twmerge
+
+import "testing"
+
+func TestStrictConfig(t *testing.T) {
+	merge := CreateTailwindMerge(func() *Config {
+		return GetStrictConfig(map[string][]string{
+			"brand": {"", "light", "dark"},
+			"ink":   nil,
+			"red":   {"975"},
+		})
+	})
+
+	tests := []struct {
+		name    string
+		classes string
+		want    string
+	}{
+		{
+			name:    "palette colors conflict",
+			classes: "text-red-500 text-blue-600",
+			want:    "text-blue-600",
+		},
+		{
+			name:    "single colors",
+			classes: "bg-white bg-transparent border-current border-black",
+			want:    "bg-transparent border-black",
+		},
+		{
+			name:    "unknown value is not a color",
+			classes: "text-huge text-red-500",
+			want:    "text-huge text-red-500",
+		},
+		{
+			name:    "unknown shade is not a color",
+			classes: "text-red-550 text-red-500",
+			want:    "text-red-550 text-red-500",
+		},
+		{
+			name:    "unknown value falls through to other groups",
+			classes: "text-lg text-red-500 text-sm",
+			want:    "text-red-500 text-sm",
+		},
+		{
+			name:    "stroke width is not a stroke color",
+			classes: "stroke-2 stroke-red-500 stroke-1",
+			want:    "stroke-red-500 stroke-1",
+		},
+		{
+			name:    "opacity modifier",
+			classes: "bg-red-500/50 bg-blue-500/[0.3]",
+			want:    "bg-blue-500/[0.3]",
+		},
+		{
+			name:    "arbitrary colors",
+			classes: "text-red-500 text-[#fff] text-(--fg)",
+			want:    "text-(--fg)",
+		},
+		{
+			name:    "extra colors",
+			classes: "bg-brand bg-brand-light bg-ink bg-red-975",
+			want:    "bg-red-975",
+		},
+		{
+			name:    "unknown extra shade",
+			classes: "bg-brand-medium bg-brand",
+			want:    "bg-brand-medium bg-brand",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := merge(tt.classes); got != tt.want {
+				t.Errorf("merge(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStrictConfig_DefaultIsLenient(t *testing.T) {
+	if got, want := TwMerge("text-huge text-red-500"), "text-red-500"; got != want {
+		t.Errorf("TwMerge() = %q, want %q", got, want)
+	}
+}
+
+func TestStrictConfig_DeterministicClassMap(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		utils := CreateConfigUtils(GetStrictConfig(nil))
+		for className, want := range map[string]string{
+			"stroke-2":       "stroke-w",
+			"stroke-red-500": "stroke",
+			"text-lg":        "font-size",
+			"text-red-500":   "text-color",
+			"text-huge":      "",
+		} {
+			if got := utils.GetClassGroupID(className); got != want {
+				t.Fatalf("GetClassGroupID(%q) = %q, want %q", className, got, want)
+			}
+		}
+	}
+}
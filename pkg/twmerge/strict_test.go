@@ -0,0 +1,95 @@
+package twmerge
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestValidateClassList(t *testing.T) {
+	tests := []struct {
+		classes string
+		want    []*ClassNameError
+	}{
+		{"p-2 hover:bg-[#fff] !font-bold md:p-4! [&>*]:p-2 bg-(--brand) custom", nil},
+		{"content-['!'] [mask-type:luminance]!", nil},
+		{`after:content-['a]:b'] content-['it\'s']`, nil},
+		{"content-['a", []*ClassNameError{{Token: "content-['a", Position: 9, Reason: "unclosed quote"}}},
+		{`content-['a\`, []*ClassNameError{{Token: `content-['a\`, Position: 11, Reason: "unfinished escape"}}},
+		{`a\ b`, nil},
+		{"bg-[#fff", []*ClassNameError{{Token: "bg-[#fff", Position: 3, Reason: "unclosed '['"}}},
+		{"bg-#fff]", []*ClassNameError{{Token: "bg-#fff]", Position: 7, Reason: "unexpected ']'"}}},
+		{"bg-[var(--x])", []*ClassNameError{{Token: "bg-[var(--x])", Position: 11, Reason: "']' closes '(' at position 7"}}},
+		{"hover::p-2", []*ClassNameError{{Token: "hover::p-2", Position: 6, Reason: "empty modifier"}}},
+		{":p-2", []*ClassNameError{{Token: ":p-2", Position: 0, Reason: "empty modifier"}}},
+		{"hover:", []*ClassNameError{{Token: "hover:", Position: 6, Reason: "missing utility"}}},
+		{"!", []*ClassNameError{{Token: "!", Position: 1, Reason: "missing utility"}}},
+		{"!p-2!", []*ClassNameError{{Token: "!p-2!", Position: 4, Reason: "repeated important modifier"}}},
+		{"p!-2", []*ClassNameError{{Token: "p!-2", Position: 1, Reason: "misplaced important modifier"}}},
+		{"hover:!!p-2", []*ClassNameError{{Token: "hover:!!p-2", Position: 7, Reason: "misplaced important modifier"}}},
+		{"!hover:p-2", []*ClassNameError{{Token: "!hover:p-2", Position: 0, Reason: "important modifier in a modifier"}}},
+		{
+			"p-2 bg-[#fff hover::m-2",
+			[]*ClassNameError{
+				{Token: "bg-[#fff", Position: 3, Reason: "unclosed '['"},
+				{Token: "hover::m-2", Position: 6, Reason: "empty modifier"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.classes, func(t *testing.T) {
+			if got := ValidateClassList(tt.classes); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateClassList(%q) = %v, want %v", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateClassList_ImportantModifierStyle(t *testing.T) {
+	tests := []struct {
+		style   ImportantModifierStyle
+		classes string
+		want    []*ClassNameError
+	}{
+		{ImportantModifierSuffix, "p-4! hover:p-2!", nil},
+		{ImportantModifierSuffix, "hover:!p-4", []*ClassNameError{{Token: "hover:!p-4", Position: 6, Reason: "important modifier must be a suffix"}}},
+		{ImportantModifierPrefix, "!p-4 hover:!p-2", nil},
+		{ImportantModifierPrefix, "hover:p-4!", []*ClassNameError{{Token: "hover:p-4!", Position: 9, Reason: "important modifier must be a prefix"}}},
+		{ImportantModifierAny, "!p-4 p-2!", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.classes, func(t *testing.T) {
+			validateClassName := createValidateClassName(&Config{ImportantModifierStyle: tt.style})
+			var got []*ClassNameError
+			for _, className := range splitClassesRegex(tt.classes) {
+				if err := validateClassName(className); err != nil {
+					got = append(got, err)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validateClassName(%q) = %v, want %v", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateStrictParseClassName(t *testing.T) {
+	parse := CreateStrictParseClassName(&Config{Separator: "_"})
+
+	parsed, err := parse("hover_p-4!")
+	if err != nil {
+		t.Fatalf("parse(%q) error = %v", "hover_p-4!", err)
+	}
+	if parsed.BaseClassName != "p-4" || !parsed.HasImportantModifier || !reflect.DeepEqual(parsed.Modifiers, []string{"hover"}) {
+		t.Errorf("parse(%q) = %+v", "hover_p-4!", parsed)
+	}
+
+	_, err = parse("hover__p-4")
+	var classNameErr *ClassNameError
+	if !errors.As(err, &classNameErr) || classNameErr.Position != 6 || classNameErr.Reason != "empty modifier" {
+		t.Errorf("parse(%q) error = %v, want empty modifier at position 6", "hover__p-4", err)
+	}
+	if want := `invalid class "hover__p-4" at position 6: empty modifier`; err == nil || err.Error() != want {
+		t.Errorf("error message = %v, want %q", err, want)
+	}
+}
@@ -0,0 +1,126 @@
+package twmerge
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// arbitraryScanner follows the brackets, parentheses, quoted strings and
+// backslash escapes of arbitrary values while a class name is read one byte
+// at a time. Quotes and escapes only count inside brackets or parentheses,
+// as in content-['a:b'], so apostrophes and backslashes elsewhere are plain
+// characters.
+type arbitraryScanner struct {
+	offset     int   // number of bytes read
+	open       []int // offsets of the unclosed brackets and parentheses
+	quote      byte  // quote character of the current string, 0 outside strings
+	quoteStart int   // offset of the quote starting the current string
+	escape     bool  // whether the next byte is escaped
+}
+
+// topLevel reports whether the next byte is outside of arbitrary values.
+func (s *arbitraryScanner) topLevel() bool {
+	return len(s.open) == 0 && s.quote == 0 && !s.escape
+}
+
+// inString reports whether the next byte is part of a quoted string or
+// escaped.
+func (s *arbitraryScanner) inString() bool {
+	return s.quote != 0 || s.escape
+}
+
+// depth returns the number of unclosed brackets and parentheses.
+func (s *arbitraryScanner) depth() int {
+	return len(s.open)
+}
+
+// unclosed returns the offset of the innermost unclosed quote, bracket or
+// parenthesis, or -1 if there is none.
+func (s *arbitraryScanner) unclosed() int {
+	if s.quote != 0 {
+		return s.quoteStart
+	}
+	if len(s.open) > 0 {
+		return s.open[len(s.open)-1]
+	}
+	return -1
+}
+
+// next consumes ch.
+func (s *arbitraryScanner) next(ch byte) {
+	switch {
+	case s.escape:
+		s.escape = false
+	case ch == '\\':
+		s.escape = len(s.open) > 0
+	case s.quote != 0:
+		if ch == s.quote {
+			s.quote = 0
+		}
+	case ch == '\'' || ch == '"':
+		if len(s.open) > 0 {
+			s.quote = ch
+			s.quoteStart = s.offset
+		}
+	case ch == '[' || ch == '(':
+		s.open = append(s.open, s.offset)
+	case ch == ']' || ch == ')':
+		if len(s.open) > 0 {
+			s.open = s.open[:len(s.open)-1]
+		}
+	}
+	s.offset++
+}
+
+// spaceAt returns the length in bytes of the whitespace character at the
+// start of s, or 0 if s does not start with whitespace.
+func spaceAt(s string) int {
+	if s == "" {
+		return 0
+	}
+	if s[0] < utf8.RuneSelf {
+		if unicode.IsSpace(rune(s[0])) {
+			return 1
+		}
+		return 0
+	}
+	if r, size := utf8.DecodeRuneInString(s); unicode.IsSpace(r) {
+		return size
+	}
+	return 0
+}
+
+// splitClasses splits a class list on whitespace, except for whitespace in
+// quoted strings of arbitrary values like content-['a b']. A string that is
+// never closed does not extend its class; the rest of the list is split as
+// usual.
+func splitClasses(classList string) []string {
+	var classNames []string
+	var scanner arbitraryScanner
+	start := -1
+
+	for i := 0; i < len(classList); i++ {
+		if size := spaceAt(classList[i:]); size > 0 && !scanner.inString() {
+			if start != -1 {
+				classNames = append(classNames, classList[start:i])
+				start = -1
+			}
+			scanner = arbitraryScanner{}
+			i += size - 1
+			continue
+		}
+		if start == -1 {
+			start = i
+		}
+		scanner.next(classList[i])
+	}
+
+	if start == -1 {
+		return classNames
+	}
+	if scanner.quote != 0 {
+		return append(classNames, strings.Fields(classList[start:])...)
+	}
+	return append(classNames, classList[start:])
+}
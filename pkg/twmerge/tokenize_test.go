@@ -0,0 +1,64 @@
+package twmerge
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitClasses(t *testing.T) {
+	tests := []struct {
+		classList string
+		want      []string
+	}{
+		{"", nil},
+		{"  p-2\tm-2\n", []string{"p-2", "m-2"}},
+		{"content-['a b'] p-2", []string{"content-['a b']", "p-2"}},
+		{`content-["a  b"]`, []string{`content-["a  b"]`}},
+		{`content-['a\' b'] p-2`, []string{`content-['a\' b']`, "p-2"}},
+		{"bg-[url(a b)] p-2", []string{"bg-[url(a", "b)]", "p-2"}},
+		{"don't p-2", []string{"don't", "p-2"}},
+		{"content-['a b p-2", []string{"content-['a", "b", "p-2"}},
+		{"bg-[#fff p-2 content-['x y']", []string{"bg-[#fff", "p-2", "content-['x y']"}},
+		{"p-2\u00a0p-4\u2003m-2", []string{"p-2", "p-4", "m-2"}},
+		{`a\ b p-2`, []string{`a\`, "b", "p-2"}},
+		{`content-['a\ b'] p-2`, []string{`content-['a\ b']`, "p-2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.classList, func(t *testing.T) {
+			if got := splitClasses(tt.classList); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitClasses(%q) = %q, want %q", tt.classList, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseQuotedArbitraryValues(t *testing.T) {
+	parse := CreateParseClassName(&Config{})
+
+	tests := []struct {
+		className string
+		modifiers []string
+		base      string
+	}{
+		{"content-['a:b']", nil, "content-['a:b']"},
+		{"after:content-['hover:x']", []string{"after"}, "content-['hover:x']"},
+		{"after:content-['a]:b']", []string{"after"}, "content-['a]:b']"},
+		{`after:content-["a]:b"]`, []string{"after"}, `content-["a]:b"]`},
+		{`content-['\']:x']`, nil, `content-['\']:x']`},
+		{"[&[data-x='a:b']]:p-2", []string{"[&[data-x='a:b']]"}, "p-2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.className, func(t *testing.T) {
+			got := parse(tt.className)
+			if !reflect.DeepEqual(got.Modifiers, tt.modifiers) || got.BaseClassName != tt.base {
+				t.Errorf("parse(%q) = %q %q, want %q %q", tt.className, got.Modifiers, got.BaseClassName, tt.modifiers, tt.base)
+			}
+		})
+	}
+}
+
+func TestMergeUnicodeWhitespace(t *testing.T) {
+	if got, want := TwMerge("p-2\u00a0p-4"), "p-4"; got != want {
+		t.Errorf("TwMerge() = %q, want %q", got, want)
+	}
+}
@@ -1,6 +1,9 @@
 package twmerge
 
-import "sync"
+import (
+	"sync"
+	"sync/atomic"
+)
 
 // CreateTailwindMerge creates a tailwind merge function with the given config factory.
 // The config is lazily initialized on first call.
@@ -16,31 +19,64 @@ func CreateTailwindMerge(getConfig func() *Config) func(classes ...string) strin
 			configUtils = CreateConfigUtils(config)
 		})
 
-		classList := TwJoin(classes...)
-		if classList == "" {
-			return ""
-		}
+		return tailwindMerge(configUtils, classes...)
+	}
+}
+
+func tailwindMerge(configUtils *ConfigUtils, classes ...string) string {
+	classList := TwJoin(classes...)
+	if classList == "" {
+		return ""
+	}
+
+	cached, ok := configUtils.Cache.Get(classList)
+	if ok {
+		return cached
+	}
+
+	result := MergeClassList(classList, configUtils)
+	configUtils.Cache.Set(classList, result)
+	return result
+}
+
+// defaultConfigUtils backs TwMerge and the package-level query helpers so
+// they share one trie and one cache.
+var (
+	defaultConfigUtils   atomic.Pointer[ConfigUtils]
+	defaultConfigMu      sync.Mutex
+	defaultConfigFactory = GetDefaultConfig
+)
+
+func getDefaultConfigUtils() *ConfigUtils {
+	if utils := defaultConfigUtils.Load(); utils != nil {
+		return utils
+	}
 
-		cached, ok := configUtils.Cache.Get(classList)
-		if ok {
-			return cached
-		}
+	defaultConfigMu.Lock()
+	defer defaultConfigMu.Unlock()
 
-		result := MergeClassList(classList, configUtils)
-		configUtils.Cache.Set(classList, result)
-		return result
+	if utils := defaultConfigUtils.Load(); utils != nil {
+		return utils
 	}
+
+	utils := CreateConfigUtils(defaultConfigFactory())
+	defaultConfigUtils.Store(utils)
+	return utils
 }
 
-// twMerge is the default TwMerge instance using the default config.
-var twMerge func(classes ...string) string
-var twMergeOnce sync.Once
+// SetDefaultConfig replaces the configuration used by TwMerge and the other
+// package-level helpers. The config is lazily initialized on next use and
+// the merge cache starts out empty.
+func SetDefaultConfig(getConfig func() *Config) {
+	defaultConfigMu.Lock()
+	defer defaultConfigMu.Unlock()
+
+	defaultConfigFactory = getConfig
+	defaultConfigUtils.Store(nil)
+}
 
 // TwMerge merges Tailwind CSS classes using the default configuration.
 // This is the main entry point for the library.
 func TwMerge(classes ...string) string {
-	twMergeOnce.Do(func() {
-		twMerge = CreateTailwindMerge(GetDefaultConfig)
-	})
-	return twMerge(classes...)
+	return tailwindMerge(getDefaultConfigUtils(), classes...)
 }
@@ -26,6 +26,109 @@ type Config struct {
 	ConflictingClassGroups         map[string][]string
 	ConflictingClassGroupModifiers map[string][]string
 	OrderSensitiveModifiers        []string
+	// Prefixes enables several prefixes at once, like "tw" for an app and
+	// "ui" for an embedded widget, and takes precedence over Prefix. Each
+	// prefix maps to the config of its classes, or to nil to use the class
+	// groups of this config. Classes only conflict with classes of the same
+	// prefix.
+	Prefixes map[string]*Config
+	// LegacyPrefix makes Prefix glued onto utilities like in Tailwind v3
+	// (Prefix "tw-" matches "tw-p-4" and "-tw-m-2") instead of being a
+	// variant like in Tailwind v4 ("tw:p-4"). Prefixes are always variants.
+	LegacyPrefix bool
+	// Separator separates modifiers from each other and from the utility,
+	// ":" if empty. Tailwind v3 allowed others, like "_" in "hover_p-4".
+	Separator string
+	// ImportantModifierStyle selects whether "p-4!", "!p-4" or both are
+	// recognized as important.
+	ImportantModifierStyle ImportantModifierStyle
+	// NormalizeImportantModifier enables rewriting the important modifiers
+	// of merged classes to the form of the Tailwind version: the v4 suffix
+	// (e.g. !p-4 → p-4!), or the v3 prefix with LegacyPrefix or
+	// ImportantModifierPrefix.
+	NormalizeImportantModifier bool
+	// VariantAliases maps modifiers to a semantically identical canonical
+	// modifier (e.g. min-md → md) so classes using either conflict.
+	VariantAliases map[string]string
+	// VariantGroups enables expanding variant groups like
+	// "hover:(bg-red-500 text-white)" before merging.
+	VariantGroups bool
+	// Shortcuts maps class names to the unprefixed utilities they stand for
+	// (e.g. btn → "inline-flex items-center px-4 py-2"). Shortcuts are
+	// expanded before merging so their utilities can be overridden.
+	Shortcuts map[string]string
+	// Breakpoints lists the responsive modifiers from smallest to largest.
+	Breakpoints []string
+	// Optimize enables removing merged classes that can never have an
+	// effect, such as duplicated non-Tailwind classes or "sm:p-2" after
+	// "p-2".
+	Optimize bool
+	// CanonicalizeArbitraryValues enables rewriting arbitrary spacing values
+	// with an exact theme equivalent (e.g. p-[1rem] → p-4) before merging.
+	CanonicalizeArbitraryValues bool
+	// SpacingUnit is the size of one step of Theme["spacing"] in rem, the
+	// --spacing theme variable. Arbitrary values are only canonicalized
+	// when it is set.
+	SpacingUnit float64
+	// SortClasses enables sorting merged classes into a stable order:
+	// non-Tailwind classes first, then by variants and by class group in
+	// ClassGroupOrder.
+	SortClasses bool
+	// ClassGroupOrder lists class groups in the order they are sorted in.
+	ClassGroupOrder []string
+	// VariantOrder lists variants in the order they are sorted in. Entries
+	// ending in "*" match variants with the given prefix (e.g. data-*).
+	VariantOrder []string
+	// Variants lists the static variants known to UnknownVariants, besides
+	// Breakpoints and VariantAliases.
+	Variants []string
+	// FunctionalVariants maps the root of variants taking a value, like
+	// "data" in data-active or "@" in @md, to the kind of value they take.
+	FunctionalVariants map[string]VariantValue
+	// CustomVariants lists the project's own variants, like those defined
+	// with @custom-variant. Entries ending in "-*" take any value.
+	CustomVariants []string
+	// CustomUtilities lists the project's own utilities, like those defined
+	// with @utility, so ApplyVariant and related functions rewrite them
+	// like Tailwind utilities. Entries ending in "-*" match any value.
+	CustomUtilities []string
+
+	// ArbitraryPropertyClassGroups maps CSS properties to the class groups
+	// setting them, so arbitrary properties like [padding:1rem] conflict
+	// with regular utilities like p-4.
+	ArbitraryPropertyClassGroups map[string][]string
+	// ClassGroupProperties lists the CSS properties each class group sets
+	// (e.g. px → padding-left, padding-right). Groups without an entry in
+	// ConflictingClassGroups conflict with every group whose properties
+	// they fully cover, and groups setting a single property are used for
+	// arbitrary properties unless ArbitraryPropertyClassGroups maps it.
+	ClassGroupProperties map[string][]string
+	// CSSPropertyLonghands maps shorthand CSS properties to the properties
+	// they set (e.g. padding → padding-top, padding-inline, ...).
+	CSSPropertyLonghands map[string][]string
+	// CSSVariables maps known CSS custom properties to the label of their
+	// type (e.g. --brand → color), so unlabeled variable values like
+	// text-(--brand) resolve to the right class group.
+	CSSVariables map[string]string
+
+	// Allowlist, if set, makes merging drop every class it does not allow,
+	// for class lists from untrusted input. See CreateSanitizer.
+	Allowlist *Allowlist
+
+	// ExperimentalParseClassName, if set, replaces the class name parser.
+	// It receives each class name along with the default parser, so it can
+	// mark classes as external or rewrite them before parsing. Like in
+	// tailwind-merge, this API may change between minor versions.
+	ExperimentalParseClassName func(className string, parseClassName func(string) ParsedClassName) ParsedClassName
+
+	// ClassGroupPriority sets which class group's validators are tried first
+	// when several groups share a trie node, higher first. Groups without an
+	// entry default to 0, or -1 if they use the "color" theme.
+	ClassGroupPriority map[string]int
+	// OnValidatorOverlap, if set, is called while the config is built for
+	// each validator that can never match because a validator of another
+	// group tried before it accepts the same values.
+	OnValidatorOverlap func(ValidatorOverlap)
 }
 
 // ParsedClassName represents a parsed Tailwind CSS class name.
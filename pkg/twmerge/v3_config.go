@@ -0,0 +1,111 @@
+package twmerge
+
+import "strings"
+
+// v4ClassGroups lists the class groups Tailwind CSS v4.0 added, which are
+// not recognized by GetV3Config along with the additions of later minor
+// versions.
+var v4ClassGroups = []string{
+	"inset-shadow", "inset-shadow-color", "inset-ring-w", "inset-ring-color",
+	"font-stretch", "transition-behavior", "color-scheme", "field-sizing",
+	"backface", "perspective", "perspective-origin", "transform-style",
+	"rotate-x", "rotate-y", "rotate-z", "scale-z", "scale-3d", "translate-z",
+	"translate-none",
+}
+
+// v4Classes maps class groups to the classes, or class parts, Tailwind CSS
+// v4.0 added to them.
+var v4Classes = map[string][]string{
+	"bg-image":      {"bg-linear", "bg-radial", "bg-conic"},
+	"outline-style": {"outline-hidden"},
+}
+
+// v4ColorParts lists the class parts of the utilities in v4Classes and
+// v4ClassGroups that colors matching any value would otherwise take in, like
+// "shadow" of "text-shadow-sm" or "linear" of "bg-linear-to-r".
+var v4ColorParts = []string{"shadow", "linear", "radial", "conic"}
+
+// isV3Color reports whether value can be a color of the v3 config, which
+// matches any value except v4ColorParts.
+func isV3Color(value string) bool {
+	for _, part := range v4ColorParts {
+		if value == part || strings.HasPrefix(value, part+classPartSeparator) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetV3Config returns a tailwind-merge configuration for Tailwind CSS v3.
+// Compared to GetDefaultConfig it adds the v3-only utilities, such as the
+// separate opacity groups (bg-opacity-*, text-opacity-*, ...), flex-grow,
+// flex-shrink, decoration-slice, overflow-ellipsis and bg-gradient-to-*,
+// drops the utilities added by v4, such as text-shadow-*, mask-* and
+// bg-linear-*, and parses prefixes the v3 way: set Prefix to e.g. "tw-" to
+// match "tw-p-4", "-tw-m-2" and "hover:!tw-p-4".
+//
+// Colors with an opacity modifier like "bg-red-500/50" override the opacity
+// group of their property, since they set the opacity themselves.
+func GetV3Config() *Config {
+	config := GetDefaultConfig()
+	config.LegacyPrefix = true
+
+	removeAdditions(config, v4ClassGroups, v4Classes)
+	for _, addition := range versionAdditions {
+		removeAdditions(config, addition.classGroups, addition.classes)
+	}
+	config.Theme["color"] = []ClassDefinition{isV3Color}
+
+	scaleOpacity := func() []ClassDefinition {
+		return []ClassDefinition{IsNumber, IsArbitraryVariable, IsArbitraryValue}
+	}
+
+	v3ClassGroups := map[string][]ClassDefinition{
+		"bg-opacity":          {m("bg-opacity", scaleOpacity()...)},
+		"text-opacity":        {m("text-opacity", scaleOpacity()...)},
+		"border-opacity":      {m("border-opacity", scaleOpacity()...)},
+		"divide-opacity":      {m("divide-opacity", scaleOpacity()...)},
+		"placeholder-opacity": {m("placeholder-opacity", scaleOpacity()...)},
+		"ring-opacity":        {m("ring-opacity", scaleOpacity()...)},
+	}
+	for classGroupID, definitions := range v3ClassGroups {
+		config.ClassGroups[classGroupID] = definitions
+	}
+
+	v3Utilities := map[string][]ClassDefinition{
+		"grow":           {m("flex-grow", d("", IsNumber, IsArbitraryVariable, IsArbitraryValue)...)},
+		"shrink":         {m("flex-shrink", d("", IsNumber, IsArbitraryVariable, IsArbitraryValue)...)},
+		"box-decoration": {m("decoration", d("slice", "clone")...)},
+		"text-overflow":  {"overflow-ellipsis"},
+		"shadow":         {m("shadow", "inner")},
+		"bg-image": {m("bg", map[string][]ClassDefinition{
+			"gradient": {m("to", d("t", "tr", "r", "br", "b", "bl", "l", "tl")...)},
+		})},
+	}
+	for classGroupID, definitions := range v3Utilities {
+		config.ClassGroups[classGroupID] = append(append([]ClassDefinition(nil), config.ClassGroups[classGroupID]...), definitions...)
+	}
+
+	opacityModifiers := map[string]string{
+		"bg-color":          "bg-opacity",
+		"text-color":        "text-opacity",
+		"border-color":      "border-opacity",
+		"divide-color":      "divide-opacity",
+		"placeholder-color": "placeholder-opacity",
+		"ring-color":        "ring-opacity",
+	}
+	for colorGroupID, opacityGroupID := range opacityModifiers {
+		config.ConflictingClassGroupModifiers[colorGroupID] = append(config.ConflictingClassGroupModifiers[colorGroupID], opacityGroupID)
+	}
+
+	order := make([]string, 0, len(config.ClassGroupOrder)+len(opacityModifiers))
+	for _, classGroupID := range config.ClassGroupOrder {
+		order = append(order, classGroupID)
+		if opacityGroupID, ok := opacityModifiers[classGroupID]; ok {
+			order = append(order, opacityGroupID)
+		}
+	}
+	config.ClassGroupOrder = order
+
+	return config
+}
@@ -0,0 +1,147 @@
+package twmerge
+
+import "testing"
+
+func TestV3Config(t *testing.T) {
+	merge := CreateTailwindMerge(GetV3Config)
+
+	tests := []struct {
+		name    string
+		classes string
+		want    string
+	}{
+		{
+			name:    "opacity groups",
+			classes: "bg-opacity-50 bg-opacity-75 text-opacity-50 text-opacity-100",
+			want:    "bg-opacity-75 text-opacity-100",
+		},
+		{
+			name:    "opacity groups do not conflict with plain colors",
+			classes: "bg-opacity-50 bg-red-500",
+			want:    "bg-opacity-50 bg-red-500",
+		},
+		{
+			name:    "color opacity modifier overrides opacity group",
+			classes: "bg-opacity-50 border-opacity-50 bg-red-500/75 border-red-500/75",
+			want:    "bg-red-500/75 border-red-500/75",
+		},
+		{
+			name:    "opacity group after color opacity modifier",
+			classes: "bg-red-500/75 bg-opacity-50",
+			want:    "bg-red-500/75 bg-opacity-50",
+		},
+		{
+			name:    "flex grow and shrink",
+			classes: "grow flex-grow-0 shrink-0 flex-shrink",
+			want:    "flex-grow-0 flex-shrink",
+		},
+		{
+			name:    "flex overrides grow",
+			classes: "flex-grow flex-1",
+			want:    "flex-1",
+		},
+		{
+			name:    "decoration slice",
+			classes: "box-decoration-clone decoration-slice decoration-red-500",
+			want:    "decoration-slice decoration-red-500",
+		},
+		{
+			name:    "overflow ellipsis",
+			classes: "truncate overflow-ellipsis",
+			want:    "overflow-ellipsis",
+		},
+		{
+			name:    "shadow inner",
+			classes: "shadow-sm shadow-inner",
+			want:    "shadow-inner",
+		},
+		{
+			name:    "gradients",
+			classes: "bg-gradient-to-r bg-red-500 bg-gradient-to-l",
+			want:    "bg-red-500 bg-gradient-to-l",
+		},
+		{
+			name:    "v4 gradients are not utilities",
+			classes: "bg-linear-to-r bg-gradient-to-l",
+			want:    "bg-linear-to-r bg-gradient-to-l",
+		},
+		{
+			name:    "v4 utilities are not utilities",
+			classes: "text-shadow-sm text-shadow-lg mask-add mask-subtract pbs-2 pbs-4 inset-shadow-sm inset-shadow-xs",
+			want:    "text-shadow-sm text-shadow-lg mask-add mask-subtract pbs-2 pbs-4 inset-shadow-sm inset-shadow-xs",
+		},
+		{
+			name:    "v4 classes of v3 utilities are not utilities",
+			classes: "items-center items-end-safe outline-none outline-hidden",
+			want:    "items-center items-end-safe outline-none outline-hidden",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := merge(tt.classes); got != tt.want {
+				t.Errorf("merge(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestV3Config_Prefix(t *testing.T) {
+	merge := CreateTailwindMerge(func() *Config {
+		config := GetV3Config()
+		config.Prefix = "tw-"
+		config.Shortcuts = map[string]string{"btn": "px-4 hover:-mt-1"}
+		config.CanonicalizeArbitraryValues = true
+		return config
+	})
+
+	tests := []struct {
+		classes string
+		want    string
+	}{
+		{"tw-p-2 tw-p-4", "tw-p-4"},
+		{"tw-px-2 tw-p-4", "tw-p-4"},
+		{"-tw-m-2 tw-m-4", "tw-m-4"},
+		{"hover:tw-p-2 hover:tw-p-4", "hover:tw-p-4"},
+		{"!tw-p-2 !tw-p-4 tw-p-3", "!tw-p-4 tw-p-3"},
+		{"tw-bg-red-500/50 tw-bg-red-500/75", "tw-bg-red-500/75"},
+		{"tw-text-lg/7 tw-leading-8 tw-text-sm/6", "tw-text-sm/6"},
+		{"p-2 p-4 tw-p-4", "p-2 p-4 tw-p-4"},
+		{"tw-p-2 tw:p-4", "tw-p-2 tw:p-4"},
+		{"tw-btn tw-px-8", "hover:-tw-mt-1 tw-px-8"},
+		{"hover:!tw-btn", "hover:!tw-px-4 hover:!-tw-mt-1"},
+		{"tw-mt-[-4px] tw-p-[1rem]", "-tw-mt-1 tw-p-4"},
+	}
+	for _, tt := range tests {
+		if got := merge(tt.classes); got != tt.want {
+			t.Errorf("merge(%q) = %q, want %q", tt.classes, got, tt.want)
+		}
+	}
+}
+
+func TestV3Config_RemovesV4ClassGroups(t *testing.T) {
+	config := GetV3Config()
+	utils := CreateConfigUtils(config)
+
+	for _, className := range []string{"text-shadow-sm", "bg-linear-to-r", "mask-add", "inset-ring-2", "rotate-x-12", "pbs-4"} {
+		if got := utils.GetClassGroupID(className); got != "" {
+			t.Errorf("GetClassGroupID(%q) = %q, want \"\"", className, got)
+		}
+	}
+
+	removed := make(map[string]bool)
+	for _, classGroupID := range v4ClassGroups {
+		removed[classGroupID] = true
+	}
+	for _, classGroupID := range config.ClassGroupOrder {
+		if removed[classGroupID] {
+			t.Errorf("ClassGroupOrder still contains %q", classGroupID)
+		}
+	}
+	for classGroupID, groups := range config.ConflictingClassGroups {
+		for _, group := range append([]string{classGroupID}, groups...) {
+			if removed[group] {
+				t.Errorf("ConflictingClassGroups still refers to %q", group)
+			}
+		}
+	}
+}
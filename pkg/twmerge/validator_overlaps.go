@@ -0,0 +1,112 @@
+package twmerge
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ValidatorOverlap reports a validator of a class group that is shadowed by
+// a validator of another group tried before it on the same trie node.
+type ValidatorOverlap struct {
+	// Path is the class prefix of the trie node, like "text" or "stroke".
+	Path string
+	// ClassGroupID is the group whose validator is shadowed.
+	ClassGroupID string
+	// ShadowedBy is the group whose validator takes its values.
+	ShadowedBy string
+	// Example is a value accepted by both validators.
+	Example string
+}
+
+func (o ValidatorOverlap) String() string {
+	className := o.Example
+	if o.Path != "" {
+		className = o.Path + classPartSeparator + o.Example
+	}
+	return fmt.Sprintf("validator of class group %q is shadowed by %q (e.g. %q)", o.ClassGroupID, o.ShadowedBy, className)
+}
+
+// FindValidatorOverlaps builds the class map of config and returns the
+// validators that can never match, because every probe value they accept is
+// already accepted by a validator of another group tried before them. Adjust
+// Config.ClassGroupPriority to fix the precedence.
+func FindValidatorOverlaps(config *Config) []ValidatorOverlap {
+	withoutHook := *config
+	withoutHook.OnValidatorOverlap = nil
+	return findValidatorOverlaps(CreateClassMap(&withoutHook))
+}
+
+// validatorProbes are sample class values covering what the built-in
+// validators tell apart.
+var validatorProbes = []string{
+	"0", "1", "2", "1.5", "12", "100", "1/2", "3/4",
+	"xs", "sm", "lg", "2xl", "3xs",
+	"red-500", "foo",
+	"[10px]", "[1.5]", "[50%]", "[#fff]", "[red]", "[var(--x)]", "[calc(1px+2px)]",
+	"[url(/a.png)]", "[linear-gradient(red,blue)]", "[0_0_2px_red]",
+	"[length:var(--x)]", "[number:var(--x)]", "[color:var(--x)]", "[image:var(--x)]",
+	"[url:var(--x)]", "[family-name:var(--x)]", "[weight:var(--x)]", "[position:var(--x)]",
+	"[percentage:var(--x)]", "[size:var(--x)]", "[bg-size:var(--x)]", "[shadow:var(--x)]",
+	"(--x)", "(length:--x)", "(number:--x)", "(color:--x)", "(image:--x)", "(url:--x)",
+	"(family-name:--x)", "(weight:--x)", "(position:--x)", "(percentage:--x)", "(size:--x)",
+	"(bg-size:--x)", "(shadow:--x)",
+}
+
+func findValidatorOverlaps(classMap *ClassPartNode) []ValidatorOverlap {
+	var overlaps []ValidatorOverlap
+	collectValidatorOverlaps(classMap, nil, &overlaps)
+	return overlaps
+}
+
+func collectValidatorOverlaps(node *ClassPartNode, path []string, overlaps *[]ValidatorOverlap) {
+	for j, shadowed := range node.Validators {
+		accepted := acceptedProbes(shadowed.Validator)
+		if len(accepted) == 0 {
+			continue
+		}
+
+		for _, earlier := range node.Validators[:j] {
+			if earlier.ClassGroupID == shadowed.ClassGroupID || !acceptsAll(earlier.Validator, accepted) {
+				continue
+			}
+
+			*overlaps = append(*overlaps, ValidatorOverlap{
+				Path:         strings.Join(path, classPartSeparator),
+				ClassGroupID: shadowed.ClassGroupID,
+				ShadowedBy:   earlier.ClassGroupID,
+				Example:      accepted[0],
+			})
+			break
+		}
+	}
+
+	parts := make([]string, 0, len(node.NextPart))
+	for part := range node.NextPart {
+		parts = append(parts, part)
+	}
+	sort.Strings(parts)
+
+	for _, part := range parts {
+		collectValidatorOverlaps(node.NextPart[part], append(path[:len(path):len(path)], part), overlaps)
+	}
+}
+
+func acceptedProbes(validator ClassValidator) []string {
+	var accepted []string
+	for _, probe := range validatorProbes {
+		if validator(probe) {
+			accepted = append(accepted, probe)
+		}
+	}
+	return accepted
+}
+
+func acceptsAll(validator ClassValidator, values []string) bool {
+	for _, value := range values {
+		if !validator(value) {
+			return false
+		}
+	}
+	return true
+}
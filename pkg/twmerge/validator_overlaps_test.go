@@ -0,0 +1,86 @@
+package twmerge
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindValidatorOverlaps_DefaultConfig(t *testing.T) {
+	// The JS reference resolves "decoration-(--x)" to the thickness as well,
+	// so this overlap is expected.
+	want := []ValidatorOverlap{{
+		Path:         "decoration",
+		ClassGroupID: "text-decoration-color",
+		ShadowedBy:   "text-decoration-thickness",
+		Example:      "(--x)",
+	}}
+
+	for name, config := range map[string]*Config{
+		"default": GetDefaultConfig(),
+		"strict":  GetStrictConfig(nil),
+	} {
+		if got := FindValidatorOverlaps(config); !reflect.DeepEqual(got, want) {
+			t.Errorf("FindValidatorOverlaps(%s) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestFindValidatorOverlaps_CustomCatchAll(t *testing.T) {
+	config := GetDefaultConfig()
+	// A short key sorts before "font-size", so the catch-all steals its values.
+	config.ClassGroups["txt"] = []ClassDefinition{m("text", IsAny)}
+
+	expected := len(FindValidatorOverlaps(GetDefaultConfig()))
+	got := FindValidatorOverlaps(config)
+	if len(got) <= expected {
+		t.Fatalf("FindValidatorOverlaps() = %v, want overlaps for the catch-all group", got)
+	}
+	for _, overlap := range got {
+		if overlap.ClassGroupID != "text-decoration-color" && (overlap.ShadowedBy != "txt" || overlap.Path != "text") {
+			t.Errorf("unexpected overlap %v", overlap)
+		}
+	}
+	if got := CreateConfigUtils(config).GetClassGroupID("text-lg"); got != "txt" {
+		t.Errorf("GetClassGroupID(text-lg) = %q, want the catch-all to steal it", got)
+	}
+
+	config.ClassGroupPriority["txt"] = -2
+	for _, overlap := range FindValidatorOverlaps(config) {
+		if overlap.ClassGroupID != "text-decoration-color" && overlap.ClassGroupID != "txt" {
+			t.Errorf("unexpected overlap with priority %v", overlap)
+		}
+	}
+	if got := CreateConfigUtils(config).GetClassGroupID("text-lg"); got != "font-size" {
+		t.Errorf("GetClassGroupID(text-lg) = %q, want %q", got, "font-size")
+	}
+}
+
+func TestOnValidatorOverlap(t *testing.T) {
+	var reported []ValidatorOverlap
+	config := GetDefaultConfig()
+	config.OnValidatorOverlap = func(overlap ValidatorOverlap) {
+		reported = append(reported, overlap)
+	}
+
+	CreateConfigUtils(config)
+
+	if !reflect.DeepEqual(reported, FindValidatorOverlaps(config)) {
+		t.Errorf("reported %v, want %v", reported, FindValidatorOverlaps(config))
+	}
+}
+
+func TestClassGroupPriority(t *testing.T) {
+	tests := []struct {
+		classes string
+		want    string
+	}{
+		{"mask-center mask-(position:--x)", "mask-(position:--x)"},
+		{"mask-none mask-[position:var(--x)]", "mask-none mask-[position:var(--x)]"},
+		{"mask-none mask-[url(/a.png)]", "mask-[url(/a.png)]"},
+	}
+	for _, tt := range tests {
+		if got := TwMerge(tt.classes); got != tt.want {
+			t.Errorf("TwMerge(%q) = %q, want %q", tt.classes, got, tt.want)
+		}
+	}
+}
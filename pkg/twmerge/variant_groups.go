@@ -0,0 +1,99 @@
+package twmerge
+
+import "strings"
+
+// ExpandVariantGroups expands Windi/UnoCSS-style variant groups into
+// individual classes, so "md:hover:(bg-blue-600 text-white) lg:(p-4 text-lg)"
+// becomes "md:hover:bg-blue-600 md:hover:text-white lg:p-4 lg:text-lg".
+// Groups may be nested. Parentheses used by arbitrary values and variables
+// like "bg-(--brand)" are left alone, as are malformed groups.
+func ExpandVariantGroups(classList string) string {
+	return expandVariantGroups(classList, string(modifierSeparator))
+}
+
+func expandVariantGroups(classList string, separator string) string {
+	if !strings.Contains(classList, separator+"(") {
+		return classList
+	}
+
+	var expanded []string
+	for _, token := range splitTopLevel(classList) {
+		expanded = append(expanded, expandVariantGroupToken(token, separator)...)
+	}
+
+	return strings.Join(expanded, " ")
+}
+
+// expandVariantGroupToken expands a single whitespace-free token. A variant
+// group starts with a '(' directly after a modifier separator outside of
+// any brackets and must close at the end of the token.
+func expandVariantGroupToken(token string, separator string) []string {
+	var scanner arbitraryScanner
+
+	for i := 0; i < len(token); i++ {
+		if token[i] == '(' && scanner.topLevel() && i > 0 && strings.HasSuffix(token[:i], separator) {
+			end := matchingParen(token, i)
+			if end != len(token)-1 {
+				return []string{token}
+			}
+
+			prefix := token[:i]
+			var expanded []string
+			for _, inner := range splitTopLevel(token[i+1 : end]) {
+				for _, className := range expandVariantGroupToken(inner, separator) {
+					expanded = append(expanded, prefix+className)
+				}
+			}
+			return expanded
+		}
+		scanner.next(token[i])
+	}
+
+	return []string{token}
+}
+
+// matchingParen returns the index of the ')' closing the '(' at start, or -1
+// if it is never closed.
+func matchingParen(s string, start int) int {
+	var scanner arbitraryScanner
+	for i := start; i < len(s); i++ {
+		closing := !scanner.inString() && (s[i] == ')' || s[i] == ']')
+		scanner.next(s[i])
+		if closing && scanner.depth() == 0 {
+			if s[i] != ')' {
+				return -1
+			}
+			return i
+		}
+	}
+	return -1
+}
+
+// splitTopLevel splits s on whitespace outside of parentheses, brackets and
+// quoted strings.
+func splitTopLevel(s string) []string {
+	var tokens []string
+	var scanner arbitraryScanner
+	start := -1
+
+	for i := 0; i < len(s); i++ {
+		if size := spaceAt(s[i:]); size > 0 && scanner.depth() == 0 && !scanner.inString() {
+			if start != -1 {
+				tokens = append(tokens, s[start:i])
+				start = -1
+			}
+			i += size - 1
+			continue
+		}
+		if start == -1 {
+			start = i
+		}
+		scanner.next(s[i])
+	}
+
+	if start != -1 {
+		tokens = append(tokens, s[start:])
+	}
+
+	return tokens
+}
@@ -0,0 +1,100 @@
+package twmerge
+
+import "testing"
+
+func TestExpandVariantGroups(t *testing.T) {
+	tests := []struct {
+		name      string
+		classList string
+		want      string
+	}{
+		{
+			name:      "no groups",
+			classList: "hover:bg-red-500 p-4",
+			want:      "hover:bg-red-500 p-4",
+		},
+		{
+			name:      "single group",
+			classList: "hover:(bg-red-500 text-white)",
+			want:      "hover:bg-red-500 hover:text-white",
+		},
+		{
+			name:      "stacked modifiers",
+			classList: "md:hover:(bg-blue-600 text-white) p-2",
+			want:      "md:hover:bg-blue-600 md:hover:text-white p-2",
+		},
+		{
+			name:      "nested groups",
+			classList: "md:(p-4 hover:(bg-red-500 underline))",
+			want:      "md:p-4 md:hover:bg-red-500 md:hover:underline",
+		},
+		{
+			name:      "arbitrary values inside group",
+			classList: "lg:(bg-[url(/img.png)] p-(--gap) [mask-type:alpha])",
+			want:      "lg:bg-[url(/img.png)] lg:p-(--gap) lg:[mask-type:alpha]",
+		},
+		{
+			name:      "arbitrary variant prefix",
+			classList: "[&>*]:(p-2 m-1)",
+			want:      "[&>*]:p-2 [&>*]:m-1",
+		},
+		{
+			name:      "arbitrary variables are not groups",
+			classList: "bg-(--brand) hover:bg-(--brand-dark)",
+			want:      "bg-(--brand) hover:bg-(--brand-dark)",
+		},
+		{
+			name:      "unclosed group is left alone",
+			classList: "hover:(bg-red-500 p-4",
+			want:      "hover:(bg-red-500 p-4",
+		},
+		{
+			name:      "trailing characters after group are left alone",
+			classList: "hover:(p-4)!",
+			want:      "hover:(p-4)!",
+		},
+		{
+			name:      "empty group",
+			classList: "hover:() p-4",
+			want:      "p-4",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExpandVariantGroups(tt.classList); got != tt.want {
+				t.Errorf("ExpandVariantGroups(%q) = %q, want %q", tt.classList, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeVariantGroups(t *testing.T) {
+	merge := CreateTailwindMerge(func() *Config {
+		config := GetDefaultConfig()
+		config.VariantGroups = true
+		return config
+	})
+
+	tests := []struct {
+		classes []string
+		want    string
+	}{
+		{
+			classes: []string{"md:hover:(bg-blue-600 text-white)", "md:hover:bg-red-600"},
+			want:    "md:hover:text-white md:hover:bg-red-600",
+		},
+		{
+			classes: []string{"lg:p-2 lg:text-sm", "lg:(p-4 text-lg)"},
+			want:    "lg:p-4 lg:text-lg",
+		},
+	}
+	for _, tt := range tests {
+		if got := merge(tt.classes...); got != tt.want {
+			t.Errorf("merge(%q) = %q, want %q", tt.classes, got, tt.want)
+		}
+	}
+
+	if got, want := TwMerge("hover:(p-2 m-1)"), "hover:(p-2 m-1)"; got != want {
+		t.Errorf("expected variant groups to be disabled by default, got %q", got)
+	}
+}
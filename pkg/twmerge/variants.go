@@ -0,0 +1,442 @@
+package twmerge
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var customVariantRegex = regexp.MustCompile(`@custom-variant\s+(-?[A-Za-z_][\w-]*)`)
+
+// VariantValue is the kind of value a functional variant like data-* takes.
+// Arbitrary values like data-[state=open] are always accepted.
+type VariantValue int
+
+const (
+	// VariantValueAny accepts any value, like data-active or aria-checked.
+	VariantValueAny VariantValue = iota
+	// VariantValueVariant accepts another known variant, like group-hover or
+	// not-focus.
+	VariantValueVariant
+	// VariantValueBreakpoint accepts a breakpoint, like max-md.
+	VariantValueBreakpoint
+	// VariantValueContainer accepts a container size from Theme["container"],
+	// like @md or @max-lg.
+	VariantValueContainer
+)
+
+// UnknownVariants returns the modifiers in classList that are neither known
+// variants nor arbitrary variants, like "hovr" in "hovr:bg-red-500", using
+// the default configuration. Each modifier is listed once, in order of first
+// appearance.
+func UnknownVariants(classList string) []string {
+	return unknownVariants(classList, getDefaultConfigUtils())
+}
+
+func unknownVariants(classList string, utils *ConfigUtils) []string {
+	var unknown []string
+	seen := make(map[string]struct{})
+
+	for _, className := range splitClassesRegex(utils.ExpandClassList(classList)) {
+		classUtils, _ := utils.utilsFor(className)
+		parsed := classUtils.ParseClassName(className)
+		if parsed.IsExternal {
+			continue
+		}
+
+		for _, modifier := range parsed.Modifiers {
+			if classUtils.IsKnownVariant(modifier) {
+				continue
+			}
+			if _, ok := seen[modifier]; ok {
+				continue
+			}
+
+			seen[modifier] = struct{}{}
+			unknown = append(unknown, modifier)
+		}
+	}
+
+	return unknown
+}
+
+// createIsKnownVariant creates the function reporting whether a modifier is
+// a variant of config: an arbitrary variant, one of config.Variants,
+// Breakpoints, VariantAliases or CustomVariants, or a functional variant
+// with a valid value. Labels like the "/item" in "group-hover/item" are
+// ignored.
+func createIsKnownVariant(config *Config) func(string) bool {
+	known := make(map[string]bool)
+	for _, variant := range config.Variants {
+		known[variant] = true
+	}
+	for variant := range config.VariantAliases {
+		known[variant] = true
+	}
+
+	breakpoints := make(map[string]bool, len(config.Breakpoints))
+	for _, breakpoint := range config.Breakpoints {
+		known[breakpoint] = true
+		breakpoints[breakpoint] = true
+	}
+
+	functional := make(map[string]VariantValue, len(config.FunctionalVariants))
+	for root, value := range config.FunctionalVariants {
+		functional[root] = value
+	}
+	for _, variant := range config.CustomVariants {
+		if root, ok := strings.CutSuffix(variant, "-*"); ok {
+			functional[root] = VariantValueAny
+		} else {
+			known[variant] = true
+		}
+	}
+
+	containerSizes := config.Theme["container"]
+
+	var isKnownVariant func(string) bool
+	isValue := func(kind VariantValue, value string) bool {
+		if IsArbitraryValue(value) {
+			return true
+		}
+		switch kind {
+		case VariantValueVariant:
+			return isKnownVariant(value)
+		case VariantValueBreakpoint:
+			return breakpoints[value]
+		case VariantValueContainer:
+			return matchesTheme(value, containerSizes)
+		default:
+			return value != ""
+		}
+	}
+
+	isKnownVariant = func(variant string) bool {
+		if IsArbitraryValue(variant) {
+			return true
+		}
+
+		if i := strings.LastIndexByte(variant, '/'); i > strings.LastIndexByte(variant, ']') {
+			variant = variant[:i]
+		}
+		if known[variant] {
+			return true
+		}
+
+		// The container variant has no separator before its value: @md.
+		if kind, ok := functional["@"]; ok && strings.HasPrefix(variant, "@") && isValue(kind, variant[1:]) {
+			return true
+		}
+
+		for i := len(variant) - 1; i > 0; i-- {
+			if variant[i] != '-' {
+				continue
+			}
+			if kind, ok := functional[variant[:i]]; ok && isValue(kind, variant[i+1:]) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return isKnownVariant
+}
+
+// CustomVariantsFromCSS extracts the names of the variants defined with
+// @custom-variant in CSS, like "theme-midnight" in
+//
+//	@custom-variant theme-midnight (&:where([data-theme=midnight] *));
+func CustomVariantsFromCSS(css string) []string {
+	var variants []string
+	for _, match := range customVariantRegex.FindAllStringSubmatch(cssCommentRegex.ReplaceAllString(css, ""), -1) {
+		variants = append(variants, match[1])
+	}
+	return variants
+}
+
+// defaultBreakpoints lists the breakpoints of Tailwind CSS v4 with their
+// --breakpoint-* sizes.
+var defaultBreakpoints = []struct {
+	name string
+	size string
+}{
+	{"sm", "40rem"}, {"md", "48rem"}, {"lg", "64rem"}, {"xl", "80rem"}, {"2xl", "96rem"},
+}
+
+// BreakpointsFromTheme returns the breakpoints of a Tailwind CSS v4
+// stylesheet from smallest to largest, for use as Config.Breakpoints: the
+// default breakpoints changed by the --breakpoint-* variables of its @theme
+// blocks, like
+//
+//	@theme { --breakpoint-3xl: 120rem; --breakpoint-sm: initial; }
+//
+// which adds "3xl" and removes "sm". "--breakpoint-*: initial" removes all
+// default breakpoints. Sizes must be rem, em or px lengths.
+func BreakpointsFromTheme(css string) ([]string, error) {
+	blocks, err := parseCSSBlocks(cssCommentRegex.ReplaceAllString(css, ""))
+	if err != nil {
+		return nil, err
+	}
+
+	sizes := make(map[string]float64, len(defaultBreakpoints))
+	for _, breakpoint := range defaultBreakpoints {
+		sizes[breakpoint.name], _ = breakpointSize(breakpoint.size)
+	}
+
+	for _, block := range blocks {
+		if block.prelude != "@theme" && !strings.HasPrefix(block.prelude, "@theme ") {
+			continue
+		}
+
+		for _, declaration := range strings.Split(block.body, ";") {
+			name, value, ok := strings.Cut(declaration, ":")
+			name = strings.TrimSpace(name)
+			value = strings.TrimSpace(value)
+			breakpoint, isBreakpoint := strings.CutPrefix(name, "--breakpoint-")
+			if !ok || !isBreakpoint {
+				continue
+			}
+
+			switch {
+			case breakpoint == "*":
+				if value == "initial" {
+					sizes = make(map[string]float64)
+				}
+			case value == "initial":
+				delete(sizes, breakpoint)
+			default:
+				size, ok := breakpointSize(value)
+				if !ok {
+					return nil, fmt.Errorf("%s: unsupported breakpoint size %q", name, value)
+				}
+				sizes[breakpoint] = size
+			}
+		}
+	}
+
+	breakpoints := make([]string, 0, len(sizes))
+	for breakpoint := range sizes {
+		breakpoints = append(breakpoints, breakpoint)
+	}
+	sort.Slice(breakpoints, func(i, j int) bool {
+		if a, b := sizes[breakpoints[i]], sizes[breakpoints[j]]; a != b {
+			return a < b
+		}
+		return breakpoints[i] < breakpoints[j]
+	})
+
+	return breakpoints, nil
+}
+
+// breakpointSize converts a breakpoint size like "40rem" or "640px" to rem.
+func breakpointSize(value string) (float64, bool) {
+	number, divisor := value, 1.0
+	switch {
+	case strings.HasSuffix(value, "rem"):
+		number = strings.TrimSuffix(value, "rem")
+	case strings.HasSuffix(value, "em"):
+		number = strings.TrimSuffix(value, "em")
+	case strings.HasSuffix(value, "px"):
+		number, divisor = strings.TrimSuffix(value, "px"), rootFontSizePx
+	default:
+		return 0, false
+	}
+
+	size, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, false
+	}
+	return size / divisor, true
+}
+
+// VariantKind classifies variants.
+type VariantKind int
+
+const (
+	// VariantState is a static variant like hover, dark or first.
+	VariantState VariantKind = iota
+	// VariantResponsive is a breakpoint like md, max-md or min-[900px].
+	VariantResponsive
+	// VariantContainer is a container query like @md, @max-lg or @[400px].
+	VariantContainer
+	// VariantGroup styles an element based on a parent, like group-hover.
+	VariantGroup
+	// VariantPeer styles an element based on a sibling, like peer-checked.
+	VariantPeer
+	// VariantData matches a data attribute, like data-active.
+	VariantData
+	// VariantAria matches an ARIA attribute, like aria-checked.
+	VariantAria
+	// VariantArbitrary is an arbitrary selector or at-rule, like [&>*].
+	VariantArbitrary
+	// VariantPseudoElement is an order-sensitive variant from
+	// Config.OrderSensitiveModifiers, like before or *.
+	VariantPseudoElement
+	// VariantFunctional is any other variant taking a value, like not-hover,
+	// has-checked or supports-grid.
+	VariantFunctional
+)
+
+var variantKindNames = [...]string{
+	VariantState:         "state",
+	VariantResponsive:    "responsive",
+	VariantContainer:     "container",
+	VariantGroup:         "group",
+	VariantPeer:          "peer",
+	VariantData:          "data",
+	VariantAria:          "aria",
+	VariantArbitrary:     "arbitrary",
+	VariantPseudoElement: "pseudo-element",
+	VariantFunctional:    "functional",
+}
+
+func (k VariantKind) String() string {
+	if k < 0 || int(k) >= len(variantKindNames) {
+		return "unknown"
+	}
+	return variantKindNames[k]
+}
+
+// Variant is the structured form of a modifier. For "group-hover/item",
+// that is the group kind with name "group", argument "hover" and label
+// "item".
+type Variant struct {
+	Kind VariantKind
+	// Name is the variant without its argument, like "hover", "group" or
+	// "@max". It is empty for arbitrary variants.
+	Name string
+	// Argument is the value of a functional variant, like "hover" in
+	// group-hover or "[state=open]" in data-[state=open], or the selector
+	// of an arbitrary variant without brackets.
+	Argument string
+	// Label is the name after the slash, like "item" in group-hover/item.
+	Label string
+}
+
+// ParseVariant parses a single modifier, like "group-hover/item" or "md",
+// using the default configuration.
+func ParseVariant(modifier string) Variant {
+	return getDefaultConfigUtils().ParseVariant(modifier)
+}
+
+// ClassVariants returns the structured form of the modifiers of className,
+// parsed with the default configuration.
+func ClassVariants(className string) []Variant {
+	return getDefaultConfigUtils().Variants(className)
+}
+
+// Variants returns the structured form of the modifiers of className. The
+// class is parsed with the utilities of its prefix, so its variants are
+// those of the config it belongs to.
+func (u *ConfigUtils) Variants(className string) []Variant {
+	classUtils, _ := u.utilsFor(className)
+	parsed := classUtils.ParseClassName(className)
+	if len(parsed.Modifiers) == 0 {
+		return nil
+	}
+
+	variants := make([]Variant, len(parsed.Modifiers))
+	for i, modifier := range parsed.Modifiers {
+		variants[i] = classUtils.ParseVariant(modifier)
+	}
+	return variants
+}
+
+// createParseVariant creates the function parsing a modifier into a
+// Variant. Unlike createIsKnownVariant, it does not validate arguments, so
+// unknown variants parse as well.
+func createParseVariant(config *Config) func(string) Variant {
+	orderSensitive := make(map[string]bool, len(config.OrderSensitiveModifiers))
+	for _, modifier := range config.OrderSensitiveModifiers {
+		orderSensitive[modifier] = true
+	}
+
+	static := make(map[string]bool, len(config.Variants))
+	for _, variant := range config.Variants {
+		static[variant] = true
+	}
+
+	functional := make(map[string]VariantValue, len(config.FunctionalVariants))
+	for root, value := range config.FunctionalVariants {
+		functional[root] = value
+	}
+	for _, variant := range config.CustomVariants {
+		if root, ok := strings.CutSuffix(variant, "-*"); ok {
+			functional[root] = VariantValueAny
+		} else {
+			static[variant] = true
+		}
+	}
+
+	breakpoints := make(map[string]bool, len(config.Breakpoints))
+	for _, breakpoint := range config.Breakpoints {
+		breakpoints[breakpoint] = true
+	}
+
+	kindOf := func(root string) VariantKind {
+		switch {
+		case root == "group":
+			return VariantGroup
+		case root == "peer":
+			return VariantPeer
+		case root == "data":
+			return VariantData
+		case root == "aria":
+			return VariantAria
+		case strings.HasPrefix(root, "@"):
+			return VariantContainer
+		}
+		if functional[root] == VariantValueBreakpoint {
+			return VariantResponsive
+		}
+		return VariantFunctional
+	}
+
+	return func(modifier string) Variant {
+		if orderSensitive[modifier] {
+			return Variant{Kind: VariantPseudoElement, Name: modifier}
+		}
+		if IsArbitraryValue(modifier) {
+			return Variant{Kind: VariantArbitrary, Argument: modifier[1 : len(modifier)-1]}
+		}
+
+		var variant Variant
+		name := modifier
+		if i := strings.LastIndexByte(name, '/'); i > strings.LastIndexByte(name, ']') {
+			name, variant.Label = name[:i], name[i+1:]
+		}
+
+		switch {
+		case breakpoints[name]:
+			variant.Kind = VariantResponsive
+			variant.Name = name
+			return variant
+		case static[name]:
+			variant.Name = name
+			return variant
+		}
+
+		for i := len(name) - 1; i > 0; i-- {
+			if name[i] != '-' {
+				continue
+			}
+			if _, ok := functional[name[:i]]; ok {
+				variant.Kind = kindOf(name[:i])
+				variant.Name, variant.Argument = name[:i], name[i+1:]
+				return variant
+			}
+		}
+
+		if _, ok := functional["@"]; ok && strings.HasPrefix(name, "@") {
+			variant.Kind = VariantContainer
+			variant.Name, variant.Argument = "@", name[1:]
+			return variant
+		}
+
+		variant.Name = name
+		return variant
+	}
+}
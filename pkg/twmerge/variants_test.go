@@ -0,0 +1,234 @@
+package twmerge
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnknownVariants(t *testing.T) {
+	tests := []struct {
+		classes string
+		want    []string
+	}{
+		{"hover:bg-red-500 focus-visible:p-2 dark:md:p-4", nil},
+		{"hovr:bg-red-500 hover:p-2 hovr:p-4 fcus:m-2", []string{"hovr", "fcus"}},
+		{"group-hover:p-2 group-hover/item:p-2 peer-checked:p-2 group-hovr:p-2", []string{"group-hovr"}},
+		{"group-aria-checked:p-2 not-hover:p-2 has-checked:p-2 in-focus:p-2", nil},
+		{"data-active:p-2 data-[state=open]:p-2 aria-[sort=asc]:p-2 supports-grid:p-2", nil},
+		{"nth-3:p-2 nth-last-of-type-2:p-2", nil},
+		{"max-md:p-2 min-lg:p-2 min-[900px]:p-2 max-huge:p-2", []string{"max-huge"}},
+		{"@md:p-2 @max-lg:p-2 @min-xs:p-2 @[400px]:p-2 @lg/main:p-2 @huge:p-2", []string{"@huge"}},
+		{"[&>*]:p-2 *:p-2 **:p-2 has-[>img]:p-2", nil},
+		{"pointer-fine:p-2 pointer-fast:p-2", []string{"pointer-fast"}},
+		{"hovr:custom-class", []string{"hovr"}},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.classes, func(t *testing.T) {
+			if got := UnknownVariants(tt.classes); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnknownVariants(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnknownVariants_CustomVariants(t *testing.T) {
+	config := GetDefaultConfig()
+	config.CustomVariants = []string{"theme-midnight", "theme-*"}
+	config.Breakpoints = append(config.Breakpoints, "3xl")
+	utils := CreateConfigUtils(config)
+
+	got := unknownVariants("theme-midnight:p-2 theme-sunset:p-2 3xl:p-2 max-3xl:p-2 them:p-2", utils)
+	if want := []string{"them"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unknownVariants() = %q, want %q", got, want)
+	}
+}
+
+func TestCustomVariantsFromCSS(t *testing.T) {
+	css := `
+@import "tailwindcss";
+
+/* @custom-variant commented-out (&:hover); */
+@custom-variant theme-midnight (&:where([data-theme=midnight] *));
+@custom-variant any-hover {
+  @media (any-hover: hover) {
+    &:hover {
+      @slot;
+    }
+  }
+}
+`
+	want := []string{"theme-midnight", "any-hover"}
+	if got := CustomVariantsFromCSS(css); !reflect.DeepEqual(got, want) {
+		t.Errorf("CustomVariantsFromCSS() = %q, want %q", got, want)
+	}
+}
+
+func TestBreakpointsFromTheme(t *testing.T) {
+	tests := []struct {
+		name string
+		css  string
+		want []string
+	}{
+		{"defaults", `@import "tailwindcss";`, []string{"sm", "md", "lg", "xl", "2xl"}},
+		{"added", `@theme { --breakpoint-3xl: 120rem; --breakpoint-xs: 30rem; }`, []string{"xs", "sm", "md", "lg", "xl", "2xl", "3xl"}},
+		{"changed size", `@theme { --breakpoint-md: 1400px; }`, []string{"sm", "lg", "xl", "md", "2xl"}},
+		{"removed", `@theme { --breakpoint-sm: initial; }`, []string{"md", "lg", "xl", "2xl"}},
+		{"reset", `@theme { --breakpoint-*: initial; --breakpoint-tablet: 40em; --breakpoint-phone: 20em; }`, []string{"phone", "tablet"}},
+		{"other blocks", `.card { --breakpoint-3xl: 120rem; } /* @theme { --breakpoint-4xl: 1rem; } */`, []string{"sm", "md", "lg", "xl", "2xl"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BreakpointsFromTheme(tt.css)
+			if err != nil {
+				t.Fatalf("BreakpointsFromTheme() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("BreakpointsFromTheme() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := BreakpointsFromTheme(`@theme { --breakpoint-3xl: calc(100vw - 1rem); }`); err == nil {
+		t.Error("BreakpointsFromTheme() error = nil, want error for an unsupported size")
+	}
+}
+
+func TestUnknownVariants_ThemeBreakpoints(t *testing.T) {
+	breakpoints, err := BreakpointsFromTheme(`@theme { --breakpoint-3xl: 120rem; }`)
+	if err != nil {
+		t.Fatalf("BreakpointsFromTheme() error = %v", err)
+	}
+
+	config := GetDefaultConfig()
+	config.Breakpoints = breakpoints
+	utils := CreateConfigUtils(config)
+	for _, variant := range []string{"3xl", "max-3xl", "min-3xl"} {
+		if !utils.IsKnownVariant(variant) {
+			t.Errorf("IsKnownVariant(%q) = false, want true", variant)
+		}
+	}
+}
+
+func TestParseVariant(t *testing.T) {
+	tests := []struct {
+		modifier string
+		want     Variant
+	}{
+		{"hover", Variant{Kind: VariantState, Name: "hover"}},
+		{"pointer-fine", Variant{Kind: VariantState, Name: "pointer-fine"}},
+		{"in-range", Variant{Kind: VariantState, Name: "in-range"}},
+		{"md", Variant{Kind: VariantResponsive, Name: "md"}},
+		{"max-md", Variant{Kind: VariantResponsive, Name: "max", Argument: "md"}},
+		{"min-[900px]", Variant{Kind: VariantResponsive, Name: "min", Argument: "[900px]"}},
+		{"@md", Variant{Kind: VariantContainer, Name: "@", Argument: "md"}},
+		{"@max-lg/main", Variant{Kind: VariantContainer, Name: "@max", Argument: "lg", Label: "main"}},
+		{"@[400px]", Variant{Kind: VariantContainer, Name: "@", Argument: "[400px]"}},
+		{"group-hover", Variant{Kind: VariantGroup, Name: "group", Argument: "hover"}},
+		{"group-hover/item", Variant{Kind: VariantGroup, Name: "group", Argument: "hover", Label: "item"}},
+		{"group-[.is-open]/nav", Variant{Kind: VariantGroup, Name: "group", Argument: "[.is-open]", Label: "nav"}},
+		{"peer-checked", Variant{Kind: VariantPeer, Name: "peer", Argument: "checked"}},
+		{"data-active", Variant{Kind: VariantData, Name: "data", Argument: "active"}},
+		{"data-[state=open]", Variant{Kind: VariantData, Name: "data", Argument: "[state=open]"}},
+		{"aria-checked", Variant{Kind: VariantAria, Name: "aria", Argument: "checked"}},
+		{"not-hover", Variant{Kind: VariantFunctional, Name: "not", Argument: "hover"}},
+		{"nth-last-of-type-2", Variant{Kind: VariantFunctional, Name: "nth-last-of-type", Argument: "2"}},
+		{"[&>*]", Variant{Kind: VariantArbitrary, Argument: "&>*"}},
+		{"[&_a/b]", Variant{Kind: VariantArbitrary, Argument: "&_a/b"}},
+		{"before", Variant{Kind: VariantPseudoElement, Name: "before"}},
+		{"*", Variant{Kind: VariantPseudoElement, Name: "*"}},
+		{"hovr", Variant{Kind: VariantState, Name: "hovr"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.modifier, func(t *testing.T) {
+			if got := ParseVariant(tt.modifier); got != tt.want {
+				t.Errorf("ParseVariant(%q) = %+v, want %+v", tt.modifier, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseVariant_CustomVariants(t *testing.T) {
+	config := GetDefaultConfig()
+	config.CustomVariants = []string{"pointer-coarse-only", "theme-*"}
+	parseVariant := CreateConfigUtils(config).ParseVariant
+
+	tests := []struct {
+		modifier string
+		want     Variant
+	}{
+		{"pointer-coarse-only", Variant{Kind: VariantState, Name: "pointer-coarse-only"}},
+		{"theme-midnight", Variant{Kind: VariantFunctional, Name: "theme", Argument: "midnight"}},
+		{"theme-[#123]/brand", Variant{Kind: VariantFunctional, Name: "theme", Argument: "[#123]", Label: "brand"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.modifier, func(t *testing.T) {
+			if got := parseVariant(tt.modifier); got != tt.want {
+				t.Errorf("ParseVariant(%q) = %+v, want %+v", tt.modifier, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassVariants(t *testing.T) {
+	got := ClassVariants("md:group-hover/item:before:p-4")
+	want := []Variant{
+		{Kind: VariantResponsive, Name: "md"},
+		{Kind: VariantGroup, Name: "group", Argument: "hover", Label: "item"},
+		{Kind: VariantPseudoElement, Name: "before"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ClassVariants() = %+v, want %+v", got, want)
+	}
+
+	if got := ClassVariants("p-4"); got != nil {
+		t.Errorf("ClassVariants() = %+v, want nil", got)
+	}
+}
+
+func TestConfigUtilsVariants(t *testing.T) {
+	config := GetDefaultConfig()
+	config.Breakpoints = []string{"tablet", "desktop"}
+	config.CustomVariants = []string{"theme-*"}
+	utils := CreateConfigUtils(config)
+
+	got := utils.Variants("tablet:theme-midnight:p-4")
+	want := []Variant{
+		{Kind: VariantResponsive, Name: "tablet"},
+		{Kind: VariantFunctional, Name: "theme", Argument: "midnight"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Variants() = %+v, want %+v", got, want)
+	}
+
+	widget := GetDefaultConfig()
+	widget.Breakpoints = []string{"narrow"}
+	config = GetDefaultConfig()
+	config.Prefixes = map[string]*Config{"tw": nil, "ui": widget}
+	utils = CreateConfigUtils(config)
+
+	got = utils.Variants("ui:narrow:p-4")
+	want = []Variant{{Kind: VariantResponsive, Name: "narrow"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Variants() = %+v, want %+v", got, want)
+	}
+}
+
+func TestMergeNamedGroupVariants(t *testing.T) {
+	tests := []struct {
+		classes string
+		want    string
+	}{
+		{"group-hover/a:p-2 group-hover/b:p-4", "group-hover/a:p-2 group-hover/b:p-4"},
+		{"group-hover/a:p-2 group-hover/a:p-4", "group-hover/a:p-4"},
+		{"group-hover:p-2 group-hover/a:p-4", "group-hover:p-2 group-hover/a:p-4"},
+		{"md:group-hover/a:p-2 group-hover/a:md:p-4", "group-hover/a:md:p-4"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.classes, func(t *testing.T) {
+			if got := TwMerge(tt.classes); got != tt.want {
+				t.Errorf("TwMerge(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
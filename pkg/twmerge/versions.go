@@ -0,0 +1,210 @@
+package twmerge
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// versionAdditions lists what each Tailwind CSS v4 minor version added on
+// top of the previous one, so older versions can be configured by removing
+// it from the default config.
+var versionAdditions = []struct {
+	minor int
+	// classGroups lists the class groups added in the version.
+	classGroups []string
+	// classes maps existing class groups to the classes, or class parts
+	// like "inset-s" of "inset-s-4", added to them.
+	classes map[string][]string
+}{
+	{
+		minor: 1,
+		classGroups: []string{
+			"text-shadow", "text-shadow-color", "drop-shadow-color", "wrap",
+			"mask-clip", "mask-composite", "mask-image", "mask-mode", "mask-origin",
+			"mask-position", "mask-repeat", "mask-size", "mask-type",
+			"mask-image-linear-pos", "mask-image-linear-from-pos", "mask-image-linear-to-pos",
+			"mask-image-linear-from-color", "mask-image-linear-to-color",
+			"mask-image-t-from-pos", "mask-image-t-to-pos", "mask-image-t-from-color", "mask-image-t-to-color",
+			"mask-image-r-from-pos", "mask-image-r-to-pos", "mask-image-r-from-color", "mask-image-r-to-color",
+			"mask-image-b-from-pos", "mask-image-b-to-pos", "mask-image-b-from-color", "mask-image-b-to-color",
+			"mask-image-l-from-pos", "mask-image-l-to-pos", "mask-image-l-from-color", "mask-image-l-to-color",
+			"mask-image-x-from-pos", "mask-image-x-to-pos", "mask-image-x-from-color", "mask-image-x-to-color",
+			"mask-image-y-from-pos", "mask-image-y-to-pos", "mask-image-y-from-color", "mask-image-y-to-color",
+			"mask-image-radial", "mask-image-radial-from-pos", "mask-image-radial-to-pos",
+			"mask-image-radial-from-color", "mask-image-radial-to-color",
+			"mask-image-radial-shape", "mask-image-radial-size", "mask-image-radial-pos",
+			"mask-image-conic-pos", "mask-image-conic-from-pos", "mask-image-conic-to-pos",
+			"mask-image-conic-from-color", "mask-image-conic-to-color",
+		},
+		classes: map[string][]string{
+			"justify-content": {"justify-center-safe", "justify-end-safe"},
+			"justify-items":   {"justify-items-center-safe", "justify-items-end-safe"},
+			"justify-self":    {"justify-self-center-safe", "justify-self-end-safe"},
+			"align-content":   {"content-center-safe", "content-end-safe"},
+			"align-items":     {"items-center-safe", "items-end-safe", "items-baseline-last"},
+			"align-self":      {"self-center-safe", "self-end-safe", "self-baseline-last"},
+			"place-content":   {"place-content-center-safe", "place-content-end-safe"},
+			"place-items":     {"place-items-center-safe", "place-items-end-safe"},
+			"place-self":      {"place-self-center-safe", "place-self-end-safe"},
+			"h":               {"h-lh"},
+			"min-h":           {"min-h-lh"},
+			"max-h":           {"max-h-lh"},
+		},
+	},
+	{
+		minor: 2,
+		classGroups: []string{
+			"inset-bs", "inset-be", "pbs", "pbe", "mbs", "mbe",
+			"inline-size", "min-inline-size", "max-inline-size",
+			"block-size", "min-block-size", "max-block-size",
+			"border-w-bs", "border-w-be", "border-color-bs", "border-color-be",
+			"scroll-mbs", "scroll-mbe", "scroll-pbs", "scroll-pbe",
+			"font-features",
+		},
+		classes: map[string][]string{
+			"start": {"inset-s"},
+			"end":   {"inset-e"},
+		},
+	},
+}
+
+// GetConfigForVersion returns the configuration for the given Tailwind CSS
+// version, like "4.0", "4.1", "4.2" or "3". A major version alone selects its
+// latest supported minor version, and patch versions are checked to be
+// numbers but otherwise ignored. Utilities added after the requested version
+// are not recognized, so a project on 4.0 keeps its own "mask-*" classes as
+// non-Tailwind classes.
+func GetConfigForVersion(version string) (*Config, error) {
+	parts := strings.Split(version, ".")
+
+	if len(parts) > 3 {
+		return nil, fmt.Errorf("invalid Tailwind CSS version %q", version)
+	}
+	for _, part := range parts {
+		if n, err := strconv.Atoi(part); err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid Tailwind CSS version %q", version)
+		}
+	}
+
+	major, _ := strconv.Atoi(parts[0])
+
+	switch major {
+	case 3:
+		return GetV3Config(), nil
+	case 4:
+	default:
+		return nil, fmt.Errorf("unsupported Tailwind CSS version %q", version)
+	}
+
+	latest := versionAdditions[len(versionAdditions)-1].minor
+	minor := latest
+	if len(parts) > 1 {
+		minor, _ = strconv.Atoi(parts[1])
+		if minor > latest {
+			return nil, fmt.Errorf("unsupported Tailwind CSS version %q", version)
+		}
+	}
+
+	config := GetDefaultConfig()
+	for _, addition := range versionAdditions {
+		if addition.minor > minor {
+			removeAdditions(config, addition.classGroups, addition.classes)
+		}
+	}
+
+	return config, nil
+}
+
+// removeAdditions removes class groups, and classes of existing class
+// groups, added by a Tailwind CSS version from config.
+func removeAdditions(config *Config, classGroupIDs []string, classes map[string][]string) {
+	removeClassGroups(config, classGroupIDs)
+	for classGroupID, classNames := range classes {
+		config.ClassGroups[classGroupID] = removeClasses(config.ClassGroups[classGroupID], "", classNames)
+	}
+}
+
+// removeClassGroups removes class groups by ID from every table of config
+// that refers to class groups.
+func removeClassGroups(config *Config, classGroupIDs []string) {
+	removed := make(map[string]struct{}, len(classGroupIDs))
+	for _, classGroupID := range classGroupIDs {
+		removed[classGroupID] = struct{}{}
+		delete(config.ClassGroups, classGroupID)
+		delete(config.ConflictingClassGroups, classGroupID)
+		delete(config.ConflictingClassGroupModifiers, classGroupID)
+		delete(config.ClassGroupProperties, classGroupID)
+		delete(config.ClassGroupPriority, classGroupID)
+	}
+
+	without := func(groups []string) []string {
+		kept := make([]string, 0, len(groups))
+		for _, group := range groups {
+			if _, ok := removed[group]; !ok {
+				kept = append(kept, group)
+			}
+		}
+		return kept
+	}
+
+	for classGroupID, groups := range config.ConflictingClassGroups {
+		config.ConflictingClassGroups[classGroupID] = without(groups)
+	}
+	for classGroupID, groups := range config.ConflictingClassGroupModifiers {
+		config.ConflictingClassGroupModifiers[classGroupID] = without(groups)
+	}
+	for property, groups := range config.ArbitraryPropertyClassGroups {
+		if kept := without(groups); len(kept) > 0 {
+			config.ArbitraryPropertyClassGroups[property] = kept
+		} else {
+			delete(config.ArbitraryPropertyClassGroups, property)
+		}
+	}
+	config.ClassGroupOrder = without(config.ClassGroupOrder)
+}
+
+// removeClasses returns definitions without the classes, or nested class
+// parts, whose full class name under prefix is one of classNames.
+func removeClasses(definitions []ClassDefinition, prefix string, classNames []string) []ClassDefinition {
+	removed := func(className string) bool {
+		for _, name := range classNames {
+			if name == className {
+				return true
+			}
+		}
+		return false
+	}
+
+	kept := make([]ClassDefinition, 0, len(definitions))
+	for _, def := range definitions {
+		switch v := def.(type) {
+		case string:
+			if removed(joinClassParts(prefix, v)) {
+				continue
+			}
+		case map[string][]ClassDefinition:
+			filtered := make(map[string][]ClassDefinition, len(v))
+			for part, subDefinitions := range v {
+				path := joinClassParts(prefix, part)
+				if removed(path) {
+					continue
+				}
+				filtered[part] = removeClasses(subDefinitions, path, classNames)
+			}
+			def = filtered
+		}
+		kept = append(kept, def)
+	}
+	return kept
+}
+
+func joinClassParts(prefix string, part string) string {
+	if prefix == "" {
+		return part
+	}
+	if part == "" {
+		return prefix
+	}
+	return prefix + classPartSeparator + part
+}
@@ -0,0 +1,133 @@
+package twmerge
+
+import "testing"
+
+func TestGetConfigForVersion(t *testing.T) {
+	tests := []struct {
+		version string
+		classes string
+		want    string
+	}{
+		// Added in 4.1
+		{"4.0", "mask-add mask-subtract", "mask-add mask-subtract"},
+		{"4.1", "mask-add mask-subtract", "mask-subtract"},
+		{"4.1", "text-shadow-lg text-shadow-none", "text-shadow-none"},
+		{"4.0", "wrap-anywhere wrap-normal", "wrap-anywhere wrap-normal"},
+		{"4.0", "items-center items-end-safe", "items-center items-end-safe"},
+		{"4.1", "items-center items-end-safe", "items-end-safe"},
+		{"4.0", "items-center items-baseline-last", "items-center items-baseline-last"},
+		{"4.0", "items-center items-baseline", "items-baseline"},
+		{"4.0", "h-12 h-lh", "h-12 h-lh"},
+		{"4.0", "drop-shadow-lg drop-shadow-red-500 drop-shadow-blue-500", "drop-shadow-lg drop-shadow-red-500 drop-shadow-blue-500"},
+
+		// Added in 4.2
+		{"4.1", "pbs-2 pbs-4", "pbs-2 pbs-4"},
+		{"4.2", "pbs-2 pbs-4", "pbs-4"},
+		{"4.1", "start-1 inset-s-2", "start-1 inset-s-2"},
+		{"4.2", "start-1 inset-s-2", "inset-s-2"},
+		{"4.1", "start-1 start-2", "start-2"},
+		{"4.1", "scroll-mbs-2 scroll-mbs-4", "scroll-mbs-2 scroll-mbs-4"},
+
+		// Utilities of older versions
+		{"4.0", "p-2 px-4 p-3", "p-3"},
+		{"4.0", "bg-red-500 bg-blue-500", "bg-blue-500"},
+		{"4", "mask-add mask-subtract", "mask-subtract"},
+		{"4.0.7", "mask-add mask-subtract", "mask-add mask-subtract"},
+		{"3", "bg-opacity-50 bg-opacity-75", "bg-opacity-75"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version+" "+tt.classes, func(t *testing.T) {
+			config, err := GetConfigForVersion(tt.version)
+			if err != nil {
+				t.Fatalf("GetConfigForVersion(%q) error = %v", tt.version, err)
+			}
+			merge := CreateTailwindMerge(func() *Config { return config })
+			if got := merge(tt.classes); got != tt.want {
+				t.Errorf("merge(%q) = %q, want %q", tt.classes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetConfigForVersion_Invalid(t *testing.T) {
+	for _, version := range []string{"", "latest", "2", "5.0", "4.9", "4.x", "4.1.x", "4.1.2.3", "4.-1", "3.x"} {
+		if _, err := GetConfigForVersion(version); err == nil {
+			t.Errorf("GetConfigForVersion(%q) error = nil, want error", version)
+		}
+	}
+}
+
+func TestVersionAdditions_Exact(t *testing.T) {
+	utils := CreateConfigUtils(GetDefaultConfig())
+	for _, addition := range versionAdditions {
+		for _, classGroupID := range addition.classGroups {
+			if _, ok := GetDefaultConfig().ClassGroups[classGroupID]; !ok {
+				t.Errorf("4.%d: class group %q is not in the default config", addition.minor, classGroupID)
+			}
+		}
+		for classGroupID, classNames := range addition.classes {
+			for _, className := range classNames {
+				got := utils.GetClassGroupID(className)
+				if got == "" {
+					// A class part, like "inset-s" of "inset-s-4".
+					got = utils.GetClassGroupID(className + "-4")
+				}
+				if got != classGroupID {
+					t.Errorf("4.%d: GetClassGroupID(%q) = %q, want %q", addition.minor, className, got, classGroupID)
+				}
+			}
+		}
+	}
+}
+
+func TestGetConfigForVersion_RemovesClassGroupReferences(t *testing.T) {
+	config, err := GetConfigForVersion("4.0")
+	if err != nil {
+		t.Fatalf("GetConfigForVersion() error = %v", err)
+	}
+
+	removed := make(map[string]bool)
+	for _, addition := range versionAdditions {
+		for _, classGroupID := range addition.classGroups {
+			removed[classGroupID] = true
+		}
+	}
+
+	check := func(table string, classGroupIDs ...string) {
+		for _, classGroupID := range classGroupIDs {
+			if removed[classGroupID] {
+				t.Errorf("%s still refers to %q", table, classGroupID)
+			}
+		}
+	}
+	for classGroupID, groups := range config.ConflictingClassGroups {
+		check("ConflictingClassGroups", append([]string{classGroupID}, groups...)...)
+	}
+	for classGroupID, groups := range config.ConflictingClassGroupModifiers {
+		check("ConflictingClassGroupModifiers", append([]string{classGroupID}, groups...)...)
+	}
+	for _, groups := range config.ArbitraryPropertyClassGroups {
+		check("ArbitraryPropertyClassGroups", groups...)
+	}
+	for classGroupID := range config.ClassGroupProperties {
+		check("ClassGroupProperties", classGroupID)
+	}
+	for classGroupID := range config.ClassGroupPriority {
+		check("ClassGroupPriority", classGroupID)
+	}
+	check("ClassGroupOrder", config.ClassGroupOrder...)
+
+	utils := CreateConfigUtils(config)
+	for _, addition := range versionAdditions {
+		for _, classNames := range addition.classes {
+			for _, className := range classNames {
+				if got := utils.GetClassGroupID(className); got != "" {
+					t.Errorf("GetClassGroupID(%q) = %q, want \"\"", className, got)
+				}
+				if got := utils.GetClassGroupID(className + "-4"); got != "" {
+					t.Errorf("GetClassGroupID(%q) = %q, want \"\"", className+"-4", got)
+				}
+			}
+		}
+	}
+}
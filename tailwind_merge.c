@@ -1,4 +1,5 @@
 #include <php.h>
+#include <php_ini.h>
 #include <zend_exceptions.h>
 
 #include "_cgo_export.h"
@@ -7,6 +8,49 @@
 
 static int (*original_php_register_internal_extensions_func)(void) = NULL;
 
+static tailwind_strict_mode strict_mode = TAILWIND_STRICT_OFF;
+
+/* Collects the elements of an array of strings into an emalloc'ed list of
+ * zend_string pointers. Throws and returns NULL on non-string elements. */
+static zend_string **collect_strings(HashTable *ht, uint32_t arg_num, int *count) {
+    *count = zend_hash_num_elements(ht);
+    if (*count == 0) {
+        return NULL;
+    }
+
+    zend_string **strings = emalloc(sizeof(zend_string *) * *count);
+    zval *entry;
+    int index = 0;
+
+    ZEND_HASH_FOREACH_VAL(ht, entry) {
+        if (Z_TYPE_P(entry) != IS_STRING) {
+            efree(strings);
+            zend_argument_type_error(arg_num, "must be an array of strings, %s given in element %d",
+                                     zend_zval_value_name(entry), index);
+            return NULL;
+        }
+
+        strings[index] = Z_STR_P(entry);
+        index++;
+    }
+    ZEND_HASH_FOREACH_END();
+
+    return strings;
+}
+
+/* Turns a malloc'ed list of C strings returned from Go into a PHP list,
+ * freeing it along the way. */
+static void return_string_list(zval *return_value, char **values, int count) {
+    array_init_size(return_value, count);
+
+    for (int i = 0; i < count; i++) {
+        add_next_index_string(return_value, values[i]);
+        free(values[i]);
+    }
+
+    free(values);
+}
+
 ZEND_FUNCTION(tailwind_merge) {
     zval *classes_zval;
 
@@ -14,33 +58,359 @@ ZEND_FUNCTION(tailwind_merge) {
         Z_PARAM_ARRAY(classes_zval)
     ZEND_PARSE_PARAMETERS_END();
 
-    HashTable *classes_ht = Z_ARRVAL_P(classes_zval);
-    int count = zend_hash_num_elements(classes_ht);
+    int count;
+    zend_string **strings = collect_strings(Z_ARRVAL_P(classes_zval), 1, &count);
+    if (strings == NULL) {
+        if (EG(exception)) {
+            RETURN_THROWS();
+        }
+        RETURN_EMPTY_STRING();
+    }
+
+    if (strict_mode != TAILWIND_STRICT_OFF) {
+        char *error = go_tailwind_validate(strings, count);
+        if (error != NULL) {
+            if (strict_mode == TAILWIND_STRICT_THROW) {
+                zend_value_error("%s", error);
+                free(error);
+                efree(strings);
+                RETURN_THROWS();
+            }
+            php_error_docref(NULL, E_WARNING, "%s", error);
+            free(error);
+        }
+    }
+
+    char *ret = go_tailwind_merge(strings, count);
+    efree(strings);
+
+    if (ret != NULL) {
+        ZVAL_STRING(return_value, ret);
+        free(ret);
+    } else {
+        RETURN_EMPTY_STRING();
+    }
+}
+
+ZEND_FUNCTION(tailwind_has_class_group) {
+    zval *classes_zval;
+    zend_string *group;
+    zval *modifiers_zval = NULL;
+    uint32_t modifiers_count = 0;
+
+    ZEND_PARSE_PARAMETERS_START(2, -1)
+        Z_PARAM_ARRAY(classes_zval)
+        Z_PARAM_STR(group)
+        Z_PARAM_VARIADIC('*', modifiers_zval, modifiers_count)
+    ZEND_PARSE_PARAMETERS_END();
+
+    int count;
+    zend_string **strings = collect_strings(Z_ARRVAL_P(classes_zval), 1, &count);
+    if (strings == NULL) {
+        if (EG(exception)) {
+            RETURN_THROWS();
+        }
+        RETURN_FALSE;
+    }
+
+    zend_string **modifiers = NULL;
+    if (modifiers_count > 0) {
+        modifiers = emalloc(sizeof(zend_string *) * modifiers_count);
+        for (uint32_t i = 0; i < modifiers_count; i++) {
+            if (Z_TYPE(modifiers_zval[i]) != IS_STRING) {
+                efree(modifiers);
+                efree(strings);
+                zend_argument_type_error(i + 3, "must be of type string, %s given",
+                                         zend_zval_value_name(&modifiers_zval[i]));
+                RETURN_THROWS();
+            }
+            modifiers[i] = Z_STR(modifiers_zval[i]);
+        }
+    }
+
+    int found = go_tailwind_has_class_group(strings, count, group, modifiers, (int) modifiers_count);
+    efree(strings);
+    if (modifiers != NULL) {
+        efree(modifiers);
+    }
+
+    RETURN_BOOL(found);
+}
+
+ZEND_FUNCTION(tailwind_class_groups) {
+    zval *classes_zval;
+
+    ZEND_PARSE_PARAMETERS_START(1, 1)
+        Z_PARAM_ARRAY(classes_zval)
+    ZEND_PARSE_PARAMETERS_END();
+
+    int count;
+    zend_string **strings = collect_strings(Z_ARRVAL_P(classes_zval), 1, &count);
+    if (strings == NULL) {
+        if (EG(exception)) {
+            RETURN_THROWS();
+        }
+        RETURN_EMPTY_ARRAY();
+    }
+
+    int groups_count = 0;
+    char **groups = go_tailwind_class_groups(strings, count, &groups_count);
+    efree(strings);
+
+    return_string_list(return_value, groups, groups_count);
+}
+
+ZEND_FUNCTION(tailwind_parse_class) {
+    zend_string *class_name;
+
+    ZEND_PARSE_PARAMETERS_START(1, 1)
+        Z_PARAM_STR(class_name)
+    ZEND_PARSE_PARAMETERS_END();
+
+    tailwind_parsed_class parsed;
+    go_tailwind_parse_class(class_name, &parsed);
+
+    zval variants;
+    array_init_size(&variants, parsed.modifiers_count);
+    for (int i = 0; i < parsed.modifiers_count; i++) {
+        zval variant;
+        array_init(&variant);
+        add_assoc_string(&variant, "kind", parsed.variant_kinds[i]);
+        add_assoc_string(&variant, "name", parsed.variant_names[i]);
+        add_assoc_string(&variant, "argument", parsed.variant_arguments[i]);
+        add_assoc_string(&variant, "label", parsed.variant_labels[i]);
+        add_next_index_zval(&variants, &variant);
+
+        free(parsed.variant_kinds[i]);
+        free(parsed.variant_names[i]);
+        free(parsed.variant_arguments[i]);
+        free(parsed.variant_labels[i]);
+    }
+    free(parsed.variant_kinds);
+    free(parsed.variant_names);
+    free(parsed.variant_arguments);
+    free(parsed.variant_labels);
+
+    zval modifiers;
+    return_string_list(&modifiers, parsed.modifiers, parsed.modifiers_count);
+
+    array_init(return_value);
+    add_assoc_zval(return_value, "modifiers", &modifiers);
+    add_assoc_zval(return_value, "variants", &variants);
+    add_assoc_bool(return_value, "important", parsed.has_important_modifier);
+    add_assoc_string(return_value, "base_class", parsed.base_class_name);
+    if (parsed.postfix_modifier_position == -1) {
+        add_assoc_null(return_value, "postfix_position");
+    } else {
+        add_assoc_long(return_value, "postfix_position", parsed.postfix_modifier_position);
+    }
+    add_assoc_bool(return_value, "external", parsed.is_external);
+
+    free(parsed.base_class_name);
+}
+
+ZEND_FUNCTION(tailwind_class_group) {
+    zend_string *class_name;
+
+    ZEND_PARSE_PARAMETERS_START(1, 1)
+        Z_PARAM_STR(class_name)
+    ZEND_PARSE_PARAMETERS_END();
+
+    char *ret = go_tailwind_class_group(class_name);
+    if (ret == NULL) {
+        RETURN_NULL();
+    }
+
+    ZVAL_STRING(return_value, ret);
+    free(ret);
+}
+
+ZEND_FUNCTION(tailwind_conflicting_class_groups) {
+    zend_string *group;
+    bool has_postfix_modifier = false;
+
+    ZEND_PARSE_PARAMETERS_START(1, 2)
+        Z_PARAM_STR(group)
+        Z_PARAM_OPTIONAL
+        Z_PARAM_BOOL(has_postfix_modifier)
+    ZEND_PARSE_PARAMETERS_END();
+
+    int groups_count = 0;
+    char **groups = go_tailwind_conflicting_class_groups(group, (int) has_postfix_modifier, &groups_count);
 
+    return_string_list(return_value, groups, groups_count);
+}
+
+ZEND_FUNCTION(tailwind_equivalent) {
+    zval *a_zval;
+    zval *b_zval;
+
+    ZEND_PARSE_PARAMETERS_START(2, 2)
+        Z_PARAM_ARRAY(a_zval)
+        Z_PARAM_ARRAY(b_zval)
+    ZEND_PARSE_PARAMETERS_END();
+
+    int a_count;
+    zend_string **a = collect_strings(Z_ARRVAL_P(a_zval), 1, &a_count);
+    if (a == NULL && EG(exception)) {
+        RETURN_THROWS();
+    }
+
+    int b_count;
+    zend_string **b = collect_strings(Z_ARRVAL_P(b_zval), 2, &b_count);
+    if (b == NULL && EG(exception)) {
+        if (a != NULL) {
+            efree(a);
+        }
+        RETURN_THROWS();
+    }
+
+    int equivalent = go_tailwind_equivalent(a, a_count, b, b_count);
+    if (a != NULL) {
+        efree(a);
+    }
+    if (b != NULL) {
+        efree(b);
+    }
+
+    RETURN_BOOL(equivalent);
+}
+
+ZEND_FUNCTION(tailwind_unknown_variants) {
+    zval *classes_zval;
+
+    ZEND_PARSE_PARAMETERS_START(1, 1)
+        Z_PARAM_ARRAY(classes_zval)
+    ZEND_PARSE_PARAMETERS_END();
+
+    int count;
+    zend_string **strings = collect_strings(Z_ARRVAL_P(classes_zval), 1, &count);
+    if (strings == NULL) {
+        if (EG(exception)) {
+            RETURN_THROWS();
+        }
+        RETURN_EMPTY_ARRAY();
+    }
+
+    int variants_count = 0;
+    char **variants = go_tailwind_unknown_variants(strings, count, &variants_count);
+    efree(strings);
+
+    return_string_list(return_value, variants, variants_count);
+}
+
+/* Shared implementation of tailwind_apply_variant() and
+ * tailwind_strip_variant(). */
+static void rewrite_variant(INTERNAL_FUNCTION_PARAMETERS, char *(*rewrite)(zend_string **, int, zend_string *)) {
+    zval *classes_zval;
+    zend_string *variant;
+
+    ZEND_PARSE_PARAMETERS_START(2, 2)
+        Z_PARAM_ARRAY(classes_zval)
+        Z_PARAM_STR(variant)
+    ZEND_PARSE_PARAMETERS_END();
+
+    int count;
+    zend_string **strings = collect_strings(Z_ARRVAL_P(classes_zval), 1, &count);
+    if (strings == NULL) {
+        if (EG(exception)) {
+            RETURN_THROWS();
+        }
+        RETURN_EMPTY_STRING();
+    }
+
+    char *ret = rewrite(strings, count, variant);
+    efree(strings);
+
+    if (ret != NULL) {
+        ZVAL_STRING(return_value, ret);
+        free(ret);
+    } else {
+        RETURN_EMPTY_STRING();
+    }
+}
+
+ZEND_FUNCTION(tailwind_apply_variant) {
+    rewrite_variant(INTERNAL_FUNCTION_PARAM_PASSTHRU, go_tailwind_apply_variant);
+}
+
+ZEND_FUNCTION(tailwind_strip_variant) {
+    rewrite_variant(INTERNAL_FUNCTION_PARAM_PASSTHRU, go_tailwind_strip_variant);
+}
+
+ZEND_FUNCTION(tailwind_swap_variant) {
+    zval *classes_zval;
+    zend_string *from;
+    zend_string *to;
+
+    ZEND_PARSE_PARAMETERS_START(3, 3)
+        Z_PARAM_ARRAY(classes_zval)
+        Z_PARAM_STR(from)
+        Z_PARAM_STR(to)
+    ZEND_PARSE_PARAMETERS_END();
+
+    int count;
+    zend_string **strings = collect_strings(Z_ARRVAL_P(classes_zval), 1, &count);
+    if (strings == NULL) {
+        if (EG(exception)) {
+            RETURN_THROWS();
+        }
+        RETURN_EMPTY_STRING();
+    }
+
+    char *ret = go_tailwind_swap_variant(strings, count, from, to);
+    efree(strings);
+
+    if (ret != NULL) {
+        ZVAL_STRING(return_value, ret);
+        free(ret);
+    } else {
+        RETURN_EMPTY_STRING();
+    }
+}
+
+ZEND_FUNCTION(tailwind_responsive) {
+    zval *classes_zval;
+
+    ZEND_PARSE_PARAMETERS_START(1, 1)
+        Z_PARAM_ARRAY(classes_zval)
+    ZEND_PARSE_PARAMETERS_END();
+
+    HashTable *ht = Z_ARRVAL_P(classes_zval);
+    int count = zend_hash_num_elements(ht);
     if (count == 0) {
         RETURN_EMPTY_STRING();
     }
 
-    /* Allocate array of zend_string pointers */
+    /* Classes under integer keys, as in ['p-2', 'md' => 'p-4'], are the
+     * base classes. */
+    zend_string *base = ZSTR_INIT_LITERAL("base", 0);
+    zend_string **variants = emalloc(sizeof(zend_string *) * count);
     zend_string **strings = emalloc(sizeof(zend_string *) * count);
+    zend_string *key;
     zval *entry;
     int index = 0;
 
-    ZEND_HASH_FOREACH_VAL(classes_ht, entry) {
+    ZEND_HASH_FOREACH_STR_KEY_VAL(ht, key, entry) {
         if (Z_TYPE_P(entry) != IS_STRING) {
+            efree(variants);
             efree(strings);
+            zend_string_release(base);
             zend_argument_type_error(1, "must be an array of strings, %s given in element %d",
                                      zend_zval_value_name(entry), index);
             RETURN_THROWS();
         }
 
+        variants[index] = key != NULL ? key : base;
         strings[index] = Z_STR_P(entry);
         index++;
     }
     ZEND_HASH_FOREACH_END();
 
-    char *ret = go_tailwind_merge(strings, count);
+    char *ret = go_tailwind_responsive(variants, strings, count);
+    efree(variants);
     efree(strings);
+    zend_string_release(base);
 
     if (ret != NULL) {
         ZVAL_STRING(return_value, ret);
@@ -50,12 +420,111 @@ ZEND_FUNCTION(tailwind_merge) {
     }
 }
 
+ZEND_FUNCTION(tailwind_sanitize) {
+    zval *classes_zval;
+
+    ZEND_PARSE_PARAMETERS_START(1, 1)
+        Z_PARAM_ARRAY(classes_zval)
+    ZEND_PARSE_PARAMETERS_END();
+
+    int count;
+    zend_string **strings = collect_strings(Z_ARRVAL_P(classes_zval), 1, &count);
+    if (strings == NULL && EG(exception)) {
+        RETURN_THROWS();
+    }
+
+    char **rejected = NULL;
+    int rejected_count = 0;
+    char *classes = go_tailwind_sanitize(strings, count, &rejected, &rejected_count);
+    if (strings != NULL) {
+        efree(strings);
+    }
+
+    zval rejected_list;
+    return_string_list(&rejected_list, rejected, rejected_count);
+
+    array_init(return_value);
+    if (classes != NULL) {
+        add_assoc_string(return_value, "classes", classes);
+        free(classes);
+    } else {
+        add_assoc_string(return_value, "classes", "");
+    }
+    add_assoc_zval(return_value, "rejected", &rejected_list);
+}
+
+PHP_INI_BEGIN()
+    PHP_INI_ENTRY("tailwind_merge.variant_groups", "0", PHP_INI_SYSTEM, NULL)
+    PHP_INI_ENTRY("tailwind_merge.shortcuts_file", "", PHP_INI_SYSTEM, NULL)
+    PHP_INI_ENTRY("tailwind_merge.optimize", "0", PHP_INI_SYSTEM, NULL)
+    PHP_INI_ENTRY("tailwind_merge.canonicalize", "0", PHP_INI_SYSTEM, NULL)
+    PHP_INI_ENTRY("tailwind_merge.sort", "0", PHP_INI_SYSTEM, NULL)
+    PHP_INI_ENTRY("tailwind_merge.strict_colors", "0", PHP_INI_SYSTEM, NULL)
+    PHP_INI_ENTRY("tailwind_merge.theme_file", "", PHP_INI_SYSTEM, NULL)
+    PHP_INI_ENTRY("tailwind_merge.prefixes", "", PHP_INI_SYSTEM, NULL)
+    PHP_INI_ENTRY("tailwind_merge.version", "4", PHP_INI_SYSTEM, NULL)
+    PHP_INI_ENTRY("tailwind_merge.prefix", "", PHP_INI_SYSTEM, NULL)
+    PHP_INI_ENTRY("tailwind_merge.separator", "", PHP_INI_SYSTEM, NULL)
+    PHP_INI_ENTRY("tailwind_merge.important", "any", PHP_INI_SYSTEM, NULL)
+    PHP_INI_ENTRY("tailwind_merge.normalize_important", "0", PHP_INI_SYSTEM, NULL)
+    PHP_INI_ENTRY("tailwind_merge.strict", "off", PHP_INI_SYSTEM, NULL)
+    PHP_INI_ENTRY("tailwind_merge.allowed_groups", "", PHP_INI_SYSTEM, NULL)
+    PHP_INI_ENTRY("tailwind_merge.allowed_classes", "", PHP_INI_SYSTEM, NULL)
+    PHP_INI_ENTRY("tailwind_merge.allow_arbitrary", "0", PHP_INI_SYSTEM, NULL)
+PHP_INI_END()
+
+PHP_MINIT_FUNCTION(tailwind_merge) {
+    REGISTER_INI_ENTRIES();
+
+    tailwind_merge_options options = {
+        .variant_groups = INI_BOOL("tailwind_merge.variant_groups"),
+        .shortcuts_file = INI_STR("tailwind_merge.shortcuts_file"),
+        .optimize = INI_BOOL("tailwind_merge.optimize"),
+        .canonicalize = INI_BOOL("tailwind_merge.canonicalize"),
+        .sort = INI_BOOL("tailwind_merge.sort"),
+        .strict_colors = INI_BOOL("tailwind_merge.strict_colors"),
+        .theme_file = INI_STR("tailwind_merge.theme_file"),
+        .prefixes = INI_STR("tailwind_merge.prefixes"),
+        .version = INI_STR("tailwind_merge.version"),
+        .prefix = INI_STR("tailwind_merge.prefix"),
+        .separator = INI_STR("tailwind_merge.separator"),
+        .important = INI_STR("tailwind_merge.important"),
+        .normalize_important = INI_BOOL("tailwind_merge.normalize_important"),
+        .allowed_groups = INI_STR("tailwind_merge.allowed_groups"),
+        .allowed_classes = INI_STR("tailwind_merge.allowed_classes"),
+        .allow_arbitrary = INI_BOOL("tailwind_merge.allow_arbitrary"),
+    };
+
+    const char *strict = INI_STR("tailwind_merge.strict");
+    if (strcmp(strict, "warn") == 0) {
+        strict_mode = TAILWIND_STRICT_WARN;
+    } else if (strcmp(strict, "throw") == 0) {
+        strict_mode = TAILWIND_STRICT_THROW;
+    } else if (*strict != '\0' && strcmp(strict, "off") != 0) {
+        php_error_docref(NULL, E_WARNING, "tailwind_merge.strict: unsupported mode \"%s\"", strict);
+    }
+
+    char *error = go_tailwind_merge_configure(&options);
+    if (error != NULL) {
+        php_error_docref(NULL, E_WARNING, "%s", error);
+        free(error);
+    }
+
+    return SUCCESS;
+}
+
+PHP_MSHUTDOWN_FUNCTION(tailwind_merge) {
+    UNREGISTER_INI_ENTRIES();
+
+    return SUCCESS;
+}
+
 zend_module_entry ext_module_entry = {
     STANDARD_MODULE_HEADER,
     "tailwind_merge",
     ext_functions,
-    NULL, /* MINIT */
-    NULL, /* MSHUTDOWN */
+    PHP_MINIT(tailwind_merge),
+    PHP_MSHUTDOWN(tailwind_merge),
     NULL, /* RINIT */
     NULL, /* RSHUTDOWN */
     NULL, /* MINFO */
@@ -5,32 +5,342 @@ package tailwindmerge
 // #include "tailwind_merge.h"
 import "C"
 import (
-	"unsafe"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
 
 	"github.com/sctr/frankenphp-tailwind-merge/pkg/twmerge"
 )
 
+// sanitize merges class lists for tailwind_sanitize() in allowlist mode. It
+// is replaced at module startup, before any request is handled.
+var sanitize = twmerge.CreateSanitizer(twmerge.GetDefaultConfig)
+
 func init() {
 	C.register_extension()
 }
 
-//export go_tailwind_merge
-func go_tailwind_merge(strings **C.zend_string, count C.int) *C.char {
-	n := int(count)
-	if n == 0 {
+// splitList splits a comma-separated ini setting, ignoring empty entries.
+func splitList(value *C.char) []string {
+	if value == nil {
 		return nil
 	}
 
-	classes := make([]string, n)
-	cStrings := unsafe.Slice(strings, n)
-	for i := 0; i < n; i++ {
-		classes[i] = zendStringToGoString(cStrings[i])
+	var values []string
+	for _, v := range strings.Split(C.GoString(value), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+//export go_tailwind_merge_configure
+func go_tailwind_merge_configure(options *C.tailwind_merge_options) *C.char {
+	variantGroups := options.variant_groups != 0
+	optimize := options.optimize != 0
+	canonicalize := options.canonicalize != 0
+	sortClasses := options.sort != 0
+	strictColors := options.strict_colors != 0
+	normalizeImportant := options.normalize_important != 0
+
+	var prefixes map[string]*twmerge.Config
+	for _, prefix := range splitList(options.prefixes) {
+		if prefixes == nil {
+			prefixes = make(map[string]*twmerge.Config)
+		}
+		prefixes[prefix] = nil
+	}
+
+	allowlist := &twmerge.Allowlist{
+		ClassGroups: splitList(options.allowed_groups),
+		Classes:     splitList(options.allowed_classes),
+		Arbitrary:   options.allow_arbitrary != 0,
+	}
+
+	var (
+		shortcuts map[string]string
+		theme     themeFile
+		errs      []error
+	)
+
+	getConfig := twmerge.GetDefaultConfig
+	if options.version != nil && *options.version != 0 {
+		version := C.GoString(options.version)
+		if versionConfig, err := twmerge.GetConfigForVersion(version); err != nil {
+			errs = append(errs, fmt.Errorf("tailwind_merge.version: %w", err))
+		} else {
+			getConfig = func() *twmerge.Config {
+				return copyConfig(versionConfig)
+			}
+		}
+	}
+
+	if allowlist.ClassGroups != nil {
+		classGroups := getConfig().ClassGroups
+		for _, classGroupID := range allowlist.ClassGroups {
+			// Arbitrary properties like [position:fixed] get the class
+			// group "arbitrary..position".
+			if _, ok := classGroups[classGroupID]; !ok && !strings.HasPrefix(classGroupID, "arbitrary..") {
+				errs = append(errs, fmt.Errorf("tailwind_merge.allowed_groups: unknown class group %q", classGroupID))
+			}
+		}
+	}
+
+	var prefix, separator string
+	if options.prefix != nil {
+		prefix = C.GoString(options.prefix)
+	}
+	if options.separator != nil {
+		separator = C.GoString(options.separator)
+	}
+
+	importantStyle := twmerge.ImportantModifierAny
+	if options.important != nil {
+		switch important := C.GoString(options.important); important {
+		case "", "any":
+		case "suffix":
+			importantStyle = twmerge.ImportantModifierSuffix
+		case "prefix":
+			importantStyle = twmerge.ImportantModifierPrefix
+		default:
+			errs = append(errs, fmt.Errorf("tailwind_merge.important: unsupported style %q", important))
+		}
+	}
+	if options.shortcuts_file != nil && *options.shortcuts_file != 0 {
+		var err error
+		if shortcuts, err = loadShortcuts(C.GoString(options.shortcuts_file)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if options.theme_file != nil && *options.theme_file != 0 {
+		var err error
+		if theme, err = loadTheme(C.GoString(options.theme_file)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	getMergeConfig := func() *twmerge.Config {
+		config := getConfig()
+		if strictColors {
+			config.Theme["color"] = twmerge.ColorTheme(twmerge.DefaultColors())
+		}
+		config.Prefix = prefix
+		config.Separator = separator
+		config.ImportantModifierStyle = importantStyle
+		config.NormalizeImportantModifier = normalizeImportant
+		config.VariantGroups = variantGroups
+		config.Shortcuts = shortcuts
+		config.Optimize = optimize
+		config.CanonicalizeArbitraryValues = canonicalize
+		config.SortClasses = sortClasses
+		config.CSSVariables = theme.cssVariables
+		config.CustomVariants = theme.customVariants
+		config.CustomUtilities = theme.customUtilities
+		if theme.breakpoints != nil {
+			config.Breakpoints = theme.breakpoints
+		}
+		config.Prefixes = prefixes
+		return config
+	}
+
+	twmerge.SetDefaultConfig(getMergeConfig)
+	sanitize = twmerge.CreateSanitizer(func() *twmerge.Config {
+		config := getMergeConfig()
+		config.Allowlist = allowlist
+		return config
+	})
+
+	if err := errors.Join(errs...); err != nil {
+		return C.CString(err.Error())
+	}
+
+	return nil
+}
+
+func loadShortcuts(path string) (map[string]string, error) {
+	css, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("tailwind_merge.shortcuts_file: %w", err)
+	}
+
+	shortcuts, err := twmerge.ShortcutsFromCSS(string(css))
+	if err != nil {
+		return nil, fmt.Errorf("tailwind_merge.shortcuts_file: %s: %w", path, err)
+	}
+
+	return shortcuts, nil
+}
+
+// copyConfig returns a copy of config whose fields and theme can be changed
+// without changing config.
+func copyConfig(config *twmerge.Config) *twmerge.Config {
+	c := *config
+	c.Theme = make(map[string][]twmerge.ClassDefinition, len(config.Theme))
+	for key, definitions := range config.Theme {
+		c.Theme[key] = definitions
+	}
+	return &c
+}
+
+// themeFile holds the settings read from tailwind_merge.theme_file.
+type themeFile struct {
+	cssVariables    map[string]string
+	customVariants  []string
+	customUtilities []string
+	breakpoints     []string
+}
+
+func loadTheme(path string) (themeFile, error) {
+	css, err := os.ReadFile(path)
+	if err != nil {
+		return themeFile{}, fmt.Errorf("tailwind_merge.theme_file: %w", err)
+	}
+
+	cssVariables, err := twmerge.CSSVariablesFromTheme(string(css))
+	if err != nil {
+		return themeFile{}, fmt.Errorf("tailwind_merge.theme_file: %s: %w", path, err)
+	}
+
+	breakpoints, err := twmerge.BreakpointsFromTheme(string(css))
+	if err != nil {
+		return themeFile{}, fmt.Errorf("tailwind_merge.theme_file: %s: %w", path, err)
+	}
+
+	return themeFile{
+		cssVariables:    cssVariables,
+		customVariants:  twmerge.CustomVariantsFromCSS(string(css)),
+		customUtilities: twmerge.CustomUtilitiesFromCSS(string(css)),
+		breakpoints:     breakpoints,
+	}, nil
+}
+
+//export go_tailwind_merge
+func go_tailwind_merge(classes **C.zend_string, count C.int) *C.char {
+	if count == 0 {
+		return nil
 	}
 
-	merged := twmerge.TwMerge(classes...)
+	merged := twmerge.TwMerge(zendStringsToGoStrings(classes, count)...)
 	if merged == "" {
 		return nil
 	}
 
 	return C.CString(merged)
 }
+
+//export go_tailwind_validate
+func go_tailwind_validate(classes **C.zend_string, count C.int) *C.char {
+	errs := twmerge.ValidateClassList(twmerge.TwJoin(zendStringsToGoStrings(classes, count)...))
+	if len(errs) == 0 {
+		return nil
+	}
+
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = err.Error()
+	}
+
+	return C.CString(strings.Join(messages, "; "))
+}
+
+//export go_tailwind_has_class_group
+func go_tailwind_has_class_group(classes **C.zend_string, count C.int, group *C.zend_string, modifiers **C.zend_string, modifiersCount C.int) C.int {
+	classList := twmerge.TwJoin(zendStringsToGoStrings(classes, count)...)
+	return cBool(twmerge.HasClassGroup(classList, zendStringToGoString(group), zendStringsToGoStrings(modifiers, modifiersCount)...))
+}
+
+//export go_tailwind_class_groups
+func go_tailwind_class_groups(classes **C.zend_string, count C.int, groupsCount *C.int) **C.char {
+	classList := twmerge.TwJoin(zendStringsToGoStrings(classes, count)...)
+	return goStringsToCArray(twmerge.ClassGroupsOf(classList), groupsCount)
+}
+
+//export go_tailwind_parse_class
+func go_tailwind_parse_class(className *C.zend_string, parsed *C.tailwind_parsed_class) {
+	result := twmerge.ParseClass(zendStringToGoString(className))
+
+	parsed.modifiers = goStringsToCArray(result.Modifiers, &parsed.modifiers_count)
+
+	variants := twmerge.ClassVariants(zendStringToGoString(className))
+	kinds := make([]string, len(variants))
+	names := make([]string, len(variants))
+	arguments := make([]string, len(variants))
+	labels := make([]string, len(variants))
+	for i, variant := range variants {
+		kinds[i] = variant.Kind.String()
+		names[i] = variant.Name
+		arguments[i] = variant.Argument
+		labels[i] = variant.Label
+	}
+
+	var count C.int
+	parsed.variant_kinds = goStringsToCArray(kinds, &count)
+	parsed.variant_names = goStringsToCArray(names, &count)
+	parsed.variant_arguments = goStringsToCArray(arguments, &count)
+	parsed.variant_labels = goStringsToCArray(labels, &count)
+	parsed.has_important_modifier = cBool(result.HasImportantModifier)
+	parsed.base_class_name = C.CString(result.BaseClassName)
+	parsed.postfix_modifier_position = C.int(result.MaybePostfixModifierPosition)
+	parsed.is_external = cBool(result.IsExternal)
+}
+
+//export go_tailwind_class_group
+func go_tailwind_class_group(className *C.zend_string) *C.char {
+	classGroupID := twmerge.GetClassGroup(zendStringToGoString(className))
+	if classGroupID == "" {
+		return nil
+	}
+
+	return C.CString(classGroupID)
+}
+
+//export go_tailwind_conflicting_class_groups
+func go_tailwind_conflicting_class_groups(group *C.zend_string, hasPostfixModifier C.int, groupsCount *C.int) **C.char {
+	return goStringsToCArray(twmerge.GetConflictingClassGroups(zendStringToGoString(group), hasPostfixModifier != 0), groupsCount)
+}
+
+//export go_tailwind_equivalent
+func go_tailwind_equivalent(a **C.zend_string, aCount C.int, b **C.zend_string, bCount C.int) C.int {
+	return cBool(twmerge.Equivalent(twmerge.TwJoin(zendStringsToGoStrings(a, aCount)...), twmerge.TwJoin(zendStringsToGoStrings(b, bCount)...)))
+}
+
+//export go_tailwind_unknown_variants
+func go_tailwind_unknown_variants(classes **C.zend_string, count C.int, variantsCount *C.int) **C.char {
+	classList := twmerge.TwJoin(zendStringsToGoStrings(classes, count)...)
+	return goStringsToCArray(twmerge.UnknownVariants(classList), variantsCount)
+}
+
+//export go_tailwind_apply_variant
+func go_tailwind_apply_variant(classes **C.zend_string, count C.int, variant *C.zend_string) *C.char {
+	return cStringOrNil(twmerge.ApplyVariant(twmerge.TwJoin(zendStringsToGoStrings(classes, count)...), zendStringToGoString(variant)))
+}
+
+//export go_tailwind_strip_variant
+func go_tailwind_strip_variant(classes **C.zend_string, count C.int, variant *C.zend_string) *C.char {
+	return cStringOrNil(twmerge.StripVariant(twmerge.TwJoin(zendStringsToGoStrings(classes, count)...), zendStringToGoString(variant)))
+}
+
+//export go_tailwind_swap_variant
+func go_tailwind_swap_variant(classes **C.zend_string, count C.int, from *C.zend_string, to *C.zend_string) *C.char {
+	return cStringOrNil(twmerge.SwapVariant(twmerge.TwJoin(zendStringsToGoStrings(classes, count)...), zendStringToGoString(from), zendStringToGoString(to)))
+}
+
+//export go_tailwind_responsive
+func go_tailwind_responsive(variants **C.zend_string, classes **C.zend_string, count C.int) *C.char {
+	responsive := make(map[string]string, int(count))
+	classLists := zendStringsToGoStrings(classes, count)
+	for i, variant := range zendStringsToGoStrings(variants, count) {
+		responsive[variant] = twmerge.TwJoin(responsive[variant], classLists[i])
+	}
+
+	return cStringOrNil(twmerge.Responsive(responsive))
+}
+
+//export go_tailwind_sanitize
+func go_tailwind_sanitize(classes **C.zend_string, count C.int, rejected ***C.char, rejectedCount *C.int) *C.char {
+	merged, rejectedClasses := sanitize(zendStringsToGoStrings(classes, count)...)
+	*rejected = goStringsToCArray(rejectedClasses, rejectedCount)
+	return cStringOrNil(merged)
+}
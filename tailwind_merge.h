@@ -1,6 +1,49 @@
 #ifndef _TAILWIND_MERGE_H
 #define _TAILWIND_MERGE_H
 
+/* A parsed class name, filled in by Go. Strings are malloc'ed and owned by
+ * the caller. A postfix_modifier_position of -1 means there is none. The
+ * variant_* arrays describe each modifier and have modifiers_count entries. */
+typedef struct {
+    char **modifiers;
+    int modifiers_count;
+    char **variant_kinds;
+    char **variant_names;
+    char **variant_arguments;
+    char **variant_labels;
+    int has_important_modifier;
+    char *base_class_name;
+    int postfix_modifier_position;
+    int is_external;
+} tailwind_parsed_class;
+
+/* How tailwind_merge() reports malformed classes (tailwind_merge.strict). */
+typedef enum {
+    TAILWIND_STRICT_OFF,
+    TAILWIND_STRICT_WARN,
+    TAILWIND_STRICT_THROW,
+} tailwind_strict_mode;
+
+/* Extension options read from php.ini at module startup. */
+typedef struct {
+    int variant_groups;
+    const char *shortcuts_file;
+    int optimize;
+    int canonicalize;
+    int sort;
+    int strict_colors;
+    const char *theme_file;
+    const char *prefixes;
+    const char *version;
+    const char *prefix;
+    const char *separator;
+    const char *important;
+    int normalize_important;
+    const char *allowed_groups;
+    const char *allowed_classes;
+    int allow_arbitrary;
+} tailwind_merge_options;
+
 void register_extension();
 
 #endif
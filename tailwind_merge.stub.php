@@ -3,3 +3,27 @@
 /** @generate-function-entries */
 
 function tailwind_merge(array $classes): string {}
+
+function tailwind_has_class_group(array $classes, string $group, string ...$modifiers): bool {}
+
+function tailwind_class_groups(array $classes): array {}
+
+function tailwind_parse_class(string $class): array {}
+
+function tailwind_class_group(string $class): ?string {}
+
+function tailwind_conflicting_class_groups(string $group, bool $has_postfix_modifier = false): array {}
+
+function tailwind_equivalent(array $a, array $b): bool {}
+
+function tailwind_unknown_variants(array $classes): array {}
+
+function tailwind_apply_variant(array $classes, string $variant): string {}
+
+function tailwind_strip_variant(array $classes, string $variant): string {}
+
+function tailwind_swap_variant(array $classes, string $from, string $to): string {}
+
+function tailwind_responsive(array $classes): string {}
+
+function tailwind_sanitize(array $classes): array {}
@@ -5,9 +5,82 @@ ZEND_BEGIN_ARG_WITH_RETURN_TYPE_INFO_EX(arginfo_tailwind_merge, 0, 1, IS_STRING,
 	ZEND_ARG_TYPE_INFO(0, classes, IS_ARRAY, 0)
 ZEND_END_ARG_INFO()
 
+ZEND_BEGIN_ARG_WITH_RETURN_TYPE_INFO_EX(arginfo_tailwind_has_class_group, 0, 2, _IS_BOOL, 0)
+	ZEND_ARG_TYPE_INFO(0, classes, IS_ARRAY, 0)
+	ZEND_ARG_TYPE_INFO(0, group, IS_STRING, 0)
+	ZEND_ARG_VARIADIC_TYPE_INFO(0, modifiers, IS_STRING, 0)
+ZEND_END_ARG_INFO()
+
+ZEND_BEGIN_ARG_WITH_RETURN_TYPE_INFO_EX(arginfo_tailwind_class_groups, 0, 1, IS_ARRAY, 0)
+	ZEND_ARG_TYPE_INFO(0, classes, IS_ARRAY, 0)
+ZEND_END_ARG_INFO()
+
+ZEND_BEGIN_ARG_WITH_RETURN_TYPE_INFO_EX(arginfo_tailwind_parse_class, 0, 1, IS_ARRAY, 0)
+	ZEND_ARG_TYPE_INFO(0, class, IS_STRING, 0)
+ZEND_END_ARG_INFO()
+
+ZEND_BEGIN_ARG_WITH_RETURN_TYPE_INFO_EX(arginfo_tailwind_class_group, 0, 1, IS_STRING, 1)
+	ZEND_ARG_TYPE_INFO(0, class, IS_STRING, 0)
+ZEND_END_ARG_INFO()
+
+ZEND_BEGIN_ARG_WITH_RETURN_TYPE_INFO_EX(arginfo_tailwind_conflicting_class_groups, 0, 1, IS_ARRAY, 0)
+	ZEND_ARG_TYPE_INFO(0, group, IS_STRING, 0)
+	ZEND_ARG_TYPE_INFO_WITH_DEFAULT_VALUE(0, has_postfix_modifier, _IS_BOOL, 0, "false")
+ZEND_END_ARG_INFO()
+
+ZEND_BEGIN_ARG_WITH_RETURN_TYPE_INFO_EX(arginfo_tailwind_equivalent, 0, 2, _IS_BOOL, 0)
+	ZEND_ARG_TYPE_INFO(0, a, IS_ARRAY, 0)
+	ZEND_ARG_TYPE_INFO(0, b, IS_ARRAY, 0)
+ZEND_END_ARG_INFO()
+
+ZEND_BEGIN_ARG_WITH_RETURN_TYPE_INFO_EX(arginfo_tailwind_unknown_variants, 0, 1, IS_ARRAY, 0)
+	ZEND_ARG_TYPE_INFO(0, classes, IS_ARRAY, 0)
+ZEND_END_ARG_INFO()
+
+ZEND_BEGIN_ARG_WITH_RETURN_TYPE_INFO_EX(arginfo_tailwind_apply_variant, 0, 2, IS_STRING, 0)
+	ZEND_ARG_TYPE_INFO(0, classes, IS_ARRAY, 0)
+	ZEND_ARG_TYPE_INFO(0, variant, IS_STRING, 0)
+ZEND_END_ARG_INFO()
+
+#define arginfo_tailwind_strip_variant arginfo_tailwind_apply_variant
+
+ZEND_BEGIN_ARG_WITH_RETURN_TYPE_INFO_EX(arginfo_tailwind_swap_variant, 0, 3, IS_STRING, 0)
+	ZEND_ARG_TYPE_INFO(0, classes, IS_ARRAY, 0)
+	ZEND_ARG_TYPE_INFO(0, from, IS_STRING, 0)
+	ZEND_ARG_TYPE_INFO(0, to, IS_STRING, 0)
+ZEND_END_ARG_INFO()
+
+#define arginfo_tailwind_responsive arginfo_tailwind_merge
+
+#define arginfo_tailwind_sanitize arginfo_tailwind_class_groups
+
 ZEND_FUNCTION(tailwind_merge);
+ZEND_FUNCTION(tailwind_has_class_group);
+ZEND_FUNCTION(tailwind_class_groups);
+ZEND_FUNCTION(tailwind_parse_class);
+ZEND_FUNCTION(tailwind_class_group);
+ZEND_FUNCTION(tailwind_conflicting_class_groups);
+ZEND_FUNCTION(tailwind_equivalent);
+ZEND_FUNCTION(tailwind_unknown_variants);
+ZEND_FUNCTION(tailwind_apply_variant);
+ZEND_FUNCTION(tailwind_strip_variant);
+ZEND_FUNCTION(tailwind_swap_variant);
+ZEND_FUNCTION(tailwind_responsive);
+ZEND_FUNCTION(tailwind_sanitize);
 
 static const zend_function_entry ext_functions[] = {
 	ZEND_FE(tailwind_merge, arginfo_tailwind_merge)
+	ZEND_FE(tailwind_has_class_group, arginfo_tailwind_has_class_group)
+	ZEND_FE(tailwind_class_groups, arginfo_tailwind_class_groups)
+	ZEND_FE(tailwind_parse_class, arginfo_tailwind_parse_class)
+	ZEND_FE(tailwind_class_group, arginfo_tailwind_class_group)
+	ZEND_FE(tailwind_conflicting_class_groups, arginfo_tailwind_conflicting_class_groups)
+	ZEND_FE(tailwind_equivalent, arginfo_tailwind_equivalent)
+	ZEND_FE(tailwind_unknown_variants, arginfo_tailwind_unknown_variants)
+	ZEND_FE(tailwind_apply_variant, arginfo_tailwind_apply_variant)
+	ZEND_FE(tailwind_strip_variant, arginfo_tailwind_strip_variant)
+	ZEND_FE(tailwind_swap_variant, arginfo_tailwind_swap_variant)
+	ZEND_FE(tailwind_responsive, arginfo_tailwind_responsive)
+	ZEND_FE(tailwind_sanitize, arginfo_tailwind_sanitize)
 	ZEND_FE_END
 };
@@ -26,3 +26,34 @@ echo "empty: " . tailwind_merge([]) . "\n";
 
 // Test: hero example
 echo "hero: " . tailwind_merge(['px-2 py-1 bg-red hover:bg-dark-red', 'p-3 bg-[#B91C1C]']) . "\n";
+
+// Test: class group queries
+echo "has_text_color: " . (tailwind_has_class_group(['p-4 text-red-500'], 'text-color') ? 'true' : 'false') . "\n";
+echo "has_hover_text_color: " . (tailwind_has_class_group(['hover:text-red-500'], 'text-color', 'hover') ? 'true' : 'false') . "\n";
+echo "class_groups: " . implode(',', tailwind_class_groups(['p-4 hover:p-2 text-lg custom'])) . "\n";
+
+// Test: class inspection
+$parsed = tailwind_parse_class('hover:text-lg/7!');
+echo "parse_class: " . implode(',', $parsed['modifiers']) . " " . $parsed['base_class'] . " " . ($parsed['important'] ? 'important' : '') . " " . $parsed['postfix_position'] . "\n";
+$variant = tailwind_parse_class('md:group-hover/item:p-4')['variants'][1];
+echo "parse_variant: " . $variant['kind'] . " " . $variant['name'] . " " . $variant['argument'] . " " . $variant['label'] . "\n";
+echo "class_group: " . tailwind_class_group('hover:text-red-500') . "\n";
+echo "class_group_unknown: " . var_export(tailwind_class_group('my-custom-class'), true) . "\n";
+echo "conflicting_class_groups: " . implode(',', tailwind_conflicting_class_groups('px')) . "\n";
+
+// Test: class list comparison
+echo "equivalent: " . (tailwind_equivalent(['p-2 p-4 text-lg card'], ['card', 'text-lg p-4']) ? 'true' : 'false') . "\n";
+echo "not_equivalent: " . (tailwind_equivalent(['p-4'], ['p-2']) ? 'true' : 'false') . "\n";
+
+// Test: variant validation
+echo "unknown_variants: " . implode(',', tailwind_unknown_variants(['hovr:p-2 hover:p-4', 'group-hover/item:p-2 fcus:m-2'])) . "\n";
+
+// Test: variant application
+echo "apply_variant: " . tailwind_apply_variant(['p-2 !m-2 card'], 'md') . "\n";
+echo "strip_variant: " . tailwind_strip_variant(['dark:bg-black p-2', 'dark:p-4'], 'dark') . "\n";
+echo "swap_variant: " . tailwind_swap_variant(['hover:p-2 p-4', 'hover:card'], 'hover', 'focus') . "\n";
+echo "responsive: " . tailwind_responsive(['p-2', 'lg' => 'p-6', 'md' => 'p-4']) . "\n";
+
+// Test: allowlist mode
+$sanitized = tailwind_sanitize(['p-2 evil-class hovr:m-2 [position:fixed]', 'p-4']);
+echo "sanitize: " . $sanitized['classes'] . " rejected: " . implode(',', $sanitized['rejected']) . "\n";